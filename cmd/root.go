@@ -24,16 +24,27 @@ package cmd
 import (
 	"os"
 
+	"github.com/eeternalsadness/jira/internal/cli/backlog"
+	"github.com/eeternalsadness/jira/internal/cli/board"
+	"github.com/eeternalsadness/jira/internal/cli/config"
 	"github.com/eeternalsadness/jira/internal/cli/configure"
+	"github.com/eeternalsadness/jira/internal/cli/doctor"
 	"github.com/eeternalsadness/jira/internal/cli/issue"
+	"github.com/eeternalsadness/jira/internal/cli/profile"
+	"github.com/eeternalsadness/jira/internal/cli/project"
+	"github.com/eeternalsadness/jira/internal/cli/sprint"
 	"github.com/eeternalsadness/jira/internal/cli/version"
+	"github.com/eeternalsadness/jira/internal/cli/workflow"
 
 	"github.com/eeternalsadness/jira/internal/util"
 
 	"github.com/spf13/cobra"
 )
 
-var cfgFile string
+var (
+	cfgFile     string
+	profileName string
+)
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -41,7 +52,7 @@ var rootCmd = &cobra.Command{
 	Short: "A CLI tool to do common Jira tasks",
 	Long:  `This CLI tool aims to carry out common Jira tasks, helping you to stay in the command line instead of breaking your workflow and going to your web browser for Jira tasks.`,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		return util.InitConfig(cmd, cfgFile)
+		return util.InitConfig(cmd, cfgFile, profileName)
 	},
 }
 
@@ -54,8 +65,19 @@ func Execute() {
 
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default is $HOME/.config/jira/config.yaml)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "profile to use (default is $JIRA_PROFILE, or 'current_profile' in the config file)")
+	util.AddConfigFlags(rootCmd)
+	util.AddOutputFlag(rootCmd)
 
 	rootCmd.AddCommand(issue.NewCommand())
 	rootCmd.AddCommand(configure.NewCommand())
 	rootCmd.AddCommand(version.NewCommand())
+	rootCmd.AddCommand(workflow.NewCommand())
+	rootCmd.AddCommand(board.NewCommand())
+	rootCmd.AddCommand(sprint.NewCommand())
+	rootCmd.AddCommand(backlog.NewCommand())
+	rootCmd.AddCommand(project.NewCommand())
+	rootCmd.AddCommand(profile.NewCommand())
+	rootCmd.AddCommand(doctor.NewCommand())
+	rootCmd.AddCommand(config.NewCommand())
 }
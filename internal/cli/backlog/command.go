@@ -0,0 +1,115 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package backlog
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var jiraClient jira.Jira
+
+// NewCommand creates and returns the backlog command
+func NewCommand() *cobra.Command {
+	backlogCmd := &cobra.Command{
+		Use:   "backlog [BOARD]",
+		Short: "List the issues in a board's backlog",
+		Long:  `List the issues in the backlog of a board by its ID or name, or of the default board, in rank order. Use 'jira issue rank' to reorder them.`,
+		Args:  cobra.MaximumNArgs(1),
+		Example: `# List the backlog of the default board
+jira backlog
+
+# List the backlog of a board by name
+jira backlog "Team board"`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := util.RunRootPreRun(cmd, args); err != nil {
+				return err
+			}
+
+			var err error
+			jiraClient, err = util.InitJiraConfig()
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			boardNameOrID := viper.GetString(string(util.DefaultBoardIDKey))
+			if len(args) > 0 {
+				boardNameOrID = args[0]
+			}
+			return listBacklog(boardNameOrID)
+		},
+	}
+
+	util.AddFormatFlag(backlogCmd)
+
+	return backlogCmd
+}
+
+func listBacklog(boardNameOrID string) error {
+	if boardNameOrID == "" {
+		return fmt.Errorf("missing board and no default board is configured")
+	}
+
+	board, err := jiraClient.FindBoard(boardNameOrID)
+	if err != nil {
+		return fmt.Errorf("failed to get board: %s", err)
+	}
+
+	field := util.StoryPointsField(&jiraClient, board.ID)
+	issues, err := jiraClient.GetBacklogIssues(board.ID, field)
+	if err != nil {
+		return fmt.Errorf("failed to get backlog of board '%s': %s", board.Name, err)
+	}
+
+	if len(issues) == 0 && util.IsTableOutput() {
+		fmt.Println("The backlog is empty.")
+		return nil
+	}
+
+	// print out issues in rank order
+	return util.PrintList(issues, backlogColumns)
+}
+
+// backlogColumns are the columns of the backlog
+var backlogColumns = []util.Column[jira.Issue]{
+	{Header: "Issue", Field: "key", Value: func(issue jira.Issue) string { return issue.Key }},
+	{Header: "Type", Field: "issueType", Value: func(issue jira.Issue) string { return issue.IssueType }},
+	{Header: "Status", Field: "status", Value: func(issue jira.Issue) string { return issue.Status }},
+	{Header: "Priority", Field: "priority", Value: func(issue jira.Issue) string { return issue.Priority }},
+	{Header: "Points", Field: "storyPoints", Value: func(issue jira.Issue) string {
+		if issue.StoryPoints == 0 {
+			return ""
+		}
+		return strconv.FormatFloat(issue.StoryPoints, 'f', -1, 64)
+	}},
+	{Header: "Summary", Field: "title", Value: func(issue jira.Issue) string { return issue.Title }},
+}
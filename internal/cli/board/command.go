@@ -0,0 +1,67 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package board
+
+import (
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var jiraClient jira.Jira
+
+// NewCommand creates and returns the board command
+func NewCommand() *cobra.Command {
+	boardCmd := &cobra.Command{
+		Use:   "board",
+		Short: "Manage Jira Software boards",
+		Long:  `List Jira Software boards, show their columns, and view them as kanban boards.`,
+		Example: `# List all scrum boards of a project
+jira board list --project PROJ --type scrum
+
+# Show the columns of a board
+jira board show 42
+
+# View a board as a kanban board
+jira board view 42`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := util.RunRootPreRun(cmd, args); err != nil {
+				return err
+			}
+
+			var err error
+			jiraClient, err = util.InitJiraConfig()
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	// Add subcommands
+	boardCmd.AddCommand(newListCommand())
+	boardCmd.AddCommand(newShowCommand())
+	boardCmd.AddCommand(newViewCommand())
+
+	return boardCmd
+}
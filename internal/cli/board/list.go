@@ -0,0 +1,86 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package board
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listProject string
+	listType    string
+)
+
+func newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [--project PROJECT] [--type scrum|kanban]",
+		Short: "List Jira Software boards",
+		Long:  `List the Jira Software boards you can see, optionally filtered by project and board type.`,
+		Args:  cobra.NoArgs,
+		Example: `# List all boards
+jira board list
+
+# List the kanban boards of a project
+jira board list --project PROJ --type kanban`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return listBoards()
+		},
+	}
+
+	cmd.Flags().StringVarP(&listProject, "project", "p", "", "only list boards of the project (key or ID)")
+	cmd.Flags().StringVarP(&listType, "type", "t", "", "only list boards of the type: scrum or kanban")
+	util.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func listBoards() error {
+	if listType != "" && listType != "scrum" && listType != "kanban" {
+		return fmt.Errorf("invalid board type '%s', must be one of: scrum, kanban", listType)
+	}
+
+	boards, err := jiraClient.GetBoards(listProject, listType)
+	if err != nil {
+		return fmt.Errorf("failed to get boards: %s", err)
+	}
+
+	if len(boards) == 0 && util.IsTableOutput() {
+		fmt.Println("No boards found.")
+		return nil
+	}
+
+	return util.PrintList(boards, boardColumns)
+}
+
+// boardColumns are the columns of board lists
+var boardColumns = []util.Column[jira.Board]{
+	{Header: "ID", Field: "id", Value: func(board jira.Board) string { return strconv.Itoa(board.ID) }},
+	{Header: "Name", Field: "name", Value: func(board jira.Board) string { return board.Name }},
+	{Header: "Type", Field: "type", Value: func(board jira.Board) string { return board.Type }},
+	{Header: "Project", Field: "projectKey", Value: func(board jira.Board) string { return board.ProjectKey }},
+}
@@ -0,0 +1,193 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package board
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+func newShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show BOARD",
+		Short: "Show the columns of a Jira Software board",
+		Long: `Show the columns of a board by its ID or name, with the statuses mapped to each column, the number of issues in each column, and the column's WIP limits.
+
+Issues are counted using the board's filter. On scrum boards, only issues in active sprints are counted.`,
+		Args: cobra.ExactArgs(1),
+		Example: `# Show a board by ID
+jira board show 42
+
+# Show a board by name
+jira board show "Team board"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return showBoard(args[0])
+		},
+	}
+
+	return cmd
+}
+
+func showBoard(boardNameOrID string) error {
+	board, err := jiraClient.FindBoard(boardNameOrID)
+	if err != nil {
+		return fmt.Errorf("failed to get board: %s", err)
+	}
+
+	configuration, err := jiraClient.GetBoardConfiguration(board.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get board configuration: %s", err)
+	}
+
+	issues, err := getBoardIssues(board)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := jiraClient.GetStatuses()
+	if err != nil {
+		return fmt.Errorf("failed to get statuses: %s", err)
+	}
+	statusNames := map[string]string{}
+	for _, status := range statuses {
+		statusNames[status.ID] = status.Name
+	}
+
+	// count issues per status, excluding sub-tasks if the WIP limits do
+	issueCounts := map[string]int{}
+	for _, issue := range issues {
+		if configuration.ColumnConstraint == "issueCountExclSubs" && issue.IsSubtask {
+			continue
+		}
+		issueCounts[issue.StatusID]++
+	}
+
+	summary := boardSummary{
+		Board:    board,
+		FilterID: configuration.FilterID,
+		Columns:  []boardColumnSummary{},
+	}
+	for _, column := range configuration.Columns {
+		columnSummary := boardColumnSummary{
+			Name:     column.Name,
+			Statuses: make([]string, len(column.StatusIDs)),
+			Min:      column.Min,
+			Max:      column.Max,
+		}
+		for i, statusID := range column.StatusIDs {
+			columnSummary.Statuses[i] = statusNames[statusID]
+			columnSummary.Issues += issueCounts[statusID]
+			delete(issueCounts, statusID)
+		}
+		summary.Columns = append(summary.Columns, columnSummary)
+	}
+
+	// issues in statuses that aren't mapped to a column don't show up on the board
+	for _, count := range issueCounts {
+		summary.UnmappedIssues += count
+	}
+
+	return util.PrintData(summary, func() error {
+		printBoardSummary(summary)
+		return nil
+	})
+}
+
+// boardSummary is a board with its columns and issue counts
+type boardSummary struct {
+	jira.Board
+	FilterID       string               `json:"filterId"`
+	Columns        []boardColumnSummary `json:"columns"`
+	UnmappedIssues int                  `json:"unmappedIssues"`
+}
+
+type boardColumnSummary struct {
+	Name     string   `json:"name"`
+	Statuses []string `json:"statuses"`
+	Issues   int      `json:"issues"`
+	Min      int      `json:"min"`
+	Max      int      `json:"max"`
+}
+
+func printBoardSummary(summary boardSummary) {
+	board := summary.Board
+	fmt.Printf("Board: %s (ID %d, %s", board.Name, board.ID, board.Type)
+	if board.ProjectKey != "" {
+		fmt.Printf(", project %s", board.ProjectKey)
+	}
+	fmt.Printf(")\nFilter: %s\n\n", summary.FilterID)
+
+	// print out columns
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Column\tStatuses\tIssues\tWIP Limit\t")
+	for _, column := range summary.Columns {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t\n", column.Name, strings.Join(column.Statuses, ", "), column.Issues, formatWIPLimit(column.Min, column.Max, column.Issues))
+	}
+	w.Flush()
+
+	if summary.UnmappedIssues > 0 {
+		fmt.Printf("\n%d issue(s) are in statuses that are not mapped to any column.\n", summary.UnmappedIssues)
+	}
+}
+
+// getBoardIssues returns the issues shown on the board, which are the issues in
+// active sprints for scrum boards
+func getBoardIssues(board jira.Board) ([]jira.Issue, error) {
+	jql := ""
+	if board.Type == "scrum" {
+		jql = "sprint in openSprints()"
+	}
+
+	issues, err := jiraClient.GetBoardIssues(board.ID, jql)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board issues: %s", err)
+	}
+
+	return issues, nil
+}
+
+func formatWIPLimit(minLimit int, maxLimit int, count int) string {
+	var limits []string
+	if minLimit > 0 {
+		limits = append(limits, fmt.Sprintf("min %d", minLimit))
+	}
+	if maxLimit > 0 {
+		limits = append(limits, fmt.Sprintf("max %d", maxLimit))
+	}
+
+	limit := strings.Join(limits, ", ")
+	switch {
+	case maxLimit > 0 && count > maxLimit:
+		limit += " (over limit)"
+	case minLimit > 0 && count < minLimit:
+		limit += " (under limit)"
+	}
+
+	return limit
+}
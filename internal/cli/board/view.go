@@ -0,0 +1,363 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package board
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+const (
+	minKanbanColumnWidth = 24
+	kanbanColumnGap      = " │ "
+)
+
+var (
+	viewPage          int
+	isViewInteractive bool
+)
+
+// kanbanColumn is a board column with the issues in it
+type kanbanColumn struct {
+	Column jira.BoardColumn
+	Issues []jira.Issue
+}
+
+func newViewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view BOARD [--page PAGE] [--interactive]",
+		Short: "View a Jira Software board as a kanban board in the terminal",
+		Long: `View a board by its ID or name as a kanban board, with one column per board column. Cards show the issue key, assignee initials, priority, and summary.
+
+Columns are sized to the terminal's width. When the columns don't fit, they are split into pages that can be selected with --page.
+
+In interactive mode, you can page through the columns and move a card to another column, which performs the matching transition.`,
+		Args: cobra.ExactArgs(1),
+		Example: `# View a board
+jira board view 42
+
+# View the second page of columns
+jira board view "Team board" --page 2
+
+# Page through the board and move cards between columns
+jira board view 42 --interactive`,
+		Annotations: map[string]string{
+			util.TableOutputOnlyAnnotation: "",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return viewBoard(args[0])
+		},
+	}
+
+	cmd.Flags().IntVar(&viewPage, "page", 1, "page of columns to show when they don't fit in the terminal")
+	cmd.Flags().BoolVarP(&isViewInteractive, "interactive", "i", false, "page through the board and move cards between columns")
+
+	return cmd
+}
+
+func viewBoard(boardNameOrID string) error {
+	board, err := jiraClient.FindBoard(boardNameOrID)
+	if err != nil {
+		return fmt.Errorf("failed to get board: %s", err)
+	}
+
+	configuration, err := jiraClient.GetBoardConfiguration(board.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get board configuration: %s", err)
+	}
+
+	columns, err := getKanbanColumns(board, configuration)
+	if err != nil {
+		return err
+	}
+
+	width := util.TerminalWidth()
+	perPage := kanbanColumnsPerPage(width)
+	pageCount := (len(columns) + perPage - 1) / perPage
+	page := min(max(viewPage, 1), pageCount)
+
+	if !isViewInteractive {
+		fmt.Print(renderKanban(board, columns, page, perPage, width))
+		if pageCount > 1 {
+			fmt.Println("Use --page to see the other columns.")
+		}
+		return nil
+	}
+
+	for {
+		fmt.Print(renderKanban(board, columns, page, perPage, width))
+
+		input, err := util.UserGetString("\n[n]ext page, [p]revious page, [m]ove ISSUE_ID COLUMN, [r]efresh, [q]uit: ", nil, true)
+		if err != nil {
+			if err == util.ErrUserQuit {
+				return nil
+			}
+			return err
+		}
+
+		words := strings.Fields(*input)
+		if len(words) == 0 {
+			continue
+		}
+
+		switch words[0] {
+		case "n":
+			page = min(page+1, pageCount)
+		case "p":
+			page = max(page-1, 1)
+		case "r":
+		case "m":
+			if len(words) < 3 {
+				fmt.Println("Usage: m ISSUE_ID COLUMN")
+				continue
+			}
+			if err := moveCard(configuration, words[1], strings.Join(words[2:], " ")); err != nil {
+				fmt.Println(util.Colorize(err.Error(), util.ColorRed))
+				continue
+			}
+		default:
+			fmt.Printf("Unknown command '%s'.\n", words[0])
+			continue
+		}
+
+		// reload the board so that moved cards and changes by others show up
+		columns, err = getKanbanColumns(board, configuration)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func getKanbanColumns(board jira.Board, configuration jira.BoardConfiguration) ([]kanbanColumn, error) {
+	if len(configuration.Columns) == 0 {
+		return nil, fmt.Errorf("board '%s' has no columns", board.Name)
+	}
+
+	issues, err := getBoardIssues(board)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]kanbanColumn, len(configuration.Columns))
+	columnIndexes := map[string]int{}
+	for i, column := range configuration.Columns {
+		columns[i] = kanbanColumn{Column: column}
+		for _, statusID := range column.StatusIDs {
+			columnIndexes[statusID] = i
+		}
+	}
+
+	// issues in statuses that aren't mapped to a column don't show up on the board
+	for _, issue := range issues {
+		if i, ok := columnIndexes[issue.StatusID]; ok {
+			columns[i].Issues = append(columns[i].Issues, issue)
+		}
+	}
+
+	return columns, nil
+}
+
+// moveCard transitions an issue to one of the statuses mapped to a column
+func moveCard(configuration jira.BoardConfiguration, issueID string, columnName string) error {
+	names := make([]string, len(configuration.Columns))
+	for i, column := range configuration.Columns {
+		names[i] = column.Name
+	}
+
+	matches := util.FuzzyMatch(columnName, names)
+	if len(matches) != 1 {
+		return fmt.Errorf("'%s' doesn't match exactly one column, columns are: %s", columnName, strings.Join(names, ", "))
+	}
+	column := configuration.Columns[matches[0]]
+
+	transitions, err := jiraClient.GetTransitions(issueID)
+	if err != nil {
+		return fmt.Errorf("failed to get valid transitions for issue: %s", err)
+	}
+
+	var candidates []jira.Transition
+	for _, transition := range transitions {
+		for _, statusID := range column.StatusIDs {
+			if transition.ToStatusID == statusID {
+				candidates = append(candidates, transition)
+				break
+			}
+		}
+	}
+
+	var transition jira.Transition
+	switch len(candidates) {
+	case 0:
+		return fmt.Errorf("issue %s can't be moved to '%s' from its current status", issueID, column.Name)
+	case 1:
+		transition = candidates[0]
+	default:
+		transitionNames := make([]string, len(candidates))
+		for i, candidate := range candidates {
+			transitionNames[i] = fmt.Sprintf("%s (to '%s')", candidate.Name, candidate.ToStatus)
+		}
+		fmt.Printf("Several transitions lead to '%s':\n", column.Name)
+		if err := util.PrettyPrintStringSlice(transitionNames); err != nil {
+			return err
+		}
+
+		index, err := util.UserSelectFromRange(len(candidates))
+		if err != nil {
+			return err
+		}
+		transition = candidates[index]
+	}
+
+	fields, err := util.TransitionFields(transition, nil, true)
+	if err != nil {
+		return err
+	}
+
+	if err := jiraClient.TransitionIssueWithOptions(issueID, transition.ID, jira.TransitionIssueOptions{Fields: fields}); err != nil {
+		return fmt.Errorf("failed when transitioning issue %s: %s", issueID, err)
+	}
+
+	fmt.Printf("Issue %s moved to '%s'.\n", issueID, column.Name)
+	return nil
+}
+
+func kanbanColumnsPerPage(width int) int {
+	gapWidth := len([]rune(kanbanColumnGap))
+	return max(1, (width+gapWidth)/(minKanbanColumnWidth+gapWidth))
+}
+
+func renderKanban(board jira.Board, columns []kanbanColumn, page int, perPage int, width int) string {
+	start := (page - 1) * perPage
+	end := min(start+perPage, len(columns))
+	pageColumns := columns[start:end]
+
+	// share the width between the columns on a page
+	gapWidth := len([]rune(kanbanColumnGap))
+	columnCount := min(perPage, len(columns))
+	columnWidth := max(minKanbanColumnWidth, (width-gapWidth*(columnCount-1))/columnCount)
+
+	// render each column as lines of text
+	renderedColumns := make([][]string, len(pageColumns))
+	rowCount := 0
+	for i, column := range pageColumns {
+		renderedColumns[i] = renderKanbanColumn(column, columnWidth)
+		rowCount = max(rowCount, len(renderedColumns[i]))
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("\n%s", board.Name))
+	if len(columns) > perPage {
+		builder.WriteString(fmt.Sprintf(" (columns %d-%d of %d, page %d of %d)", start+1, end, len(columns), page, (len(columns)+perPage-1)/perPage))
+	}
+	builder.WriteString("\n\n")
+
+	for row := range rowCount {
+		cells := make([]string, len(renderedColumns))
+		for i, lines := range renderedColumns {
+			line := ""
+			if row < len(lines) {
+				line = lines[row]
+			}
+			cells[i] = util.Pad(line, columnWidth)
+		}
+		builder.WriteString(strings.TrimRight(strings.Join(cells, kanbanColumnGap), " "))
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+func renderKanbanColumn(column kanbanColumn, width int) []string {
+	header := fmt.Sprintf("%s (%d)", column.Column.Name, len(column.Issues))
+	if column.Column.Max > 0 {
+		header = fmt.Sprintf("%s (%d/%d)", column.Column.Name, len(column.Issues), column.Column.Max)
+	}
+
+	lines := []string{
+		util.Truncate(header, width),
+		strings.Repeat("─", width),
+	}
+	for _, issue := range column.Issues {
+		details := []string{issue.Key, initials(issue.Assignee)}
+		if issue.Priority != "" {
+			details = append(details, issue.Priority)
+		}
+
+		lines = append(lines, util.Truncate(strings.Join(details, " · "), width))
+		lines = append(lines, wrapText(issue.Title, width, 2)...)
+		lines = append(lines, "")
+	}
+
+	return lines
+}
+
+// initials returns the uppercase initials of the first and last names, or '--'
+// for unassigned issues
+func initials(name string) string {
+	words := strings.Fields(name)
+	switch len(words) {
+	case 0:
+		return "--"
+	case 1:
+		return strings.ToUpper(string([]rune(words[0])[:1]))
+	default:
+		first := []rune(words[0])[:1]
+		last := []rune(words[len(words)-1])[:1]
+		return strings.ToUpper(string(first) + string(last))
+	}
+}
+
+// wrapText wraps the text at word boundaries into at most maxLines lines,
+// truncating the last line if the text doesn't fit
+func wrapText(text string, width int, maxLines int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		switch {
+		case line == "":
+			line = word
+		case len([]rune(line))+1+len([]rune(word)) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	if len(lines) > maxLines {
+		lines[maxLines-1] = strings.Join(lines[maxLines-1:], " ")
+		lines = lines[:maxLines]
+	}
+	for i, line := range lines {
+		lines[i] = util.Truncate(line, width)
+	}
+
+	return lines
+}
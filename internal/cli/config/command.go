@@ -0,0 +1,130 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// NewCommand creates and returns the config command
+func NewCommand() *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Get and set configuration values",
+		Long: `Get, set, and unset the configuration values of the active profile without prompting, e.g. from scripts or dotfiles.
+
+Keys and values are checked before they're saved. Run 'jira config view' to list every key with its value and where the value comes from: a global flag, an environment variable, the repository config file ('.jira.yaml' or '.jira/config.yaml'), or the config file.`,
+		Example: `# Set the default board
+jira config set default_board_id 42
+
+# Read a value in a script
+domain=$(jira config get domain)
+
+# Show the configuration, with secrets redacted
+jira config view`,
+	}
+
+	// Add subcommands
+	configCmd.AddCommand(newGetCommand())
+	configCmd.AddCommand(newSetCommand())
+	configCmd.AddCommand(newUnsetCommand())
+	configCmd.AddCommand(newViewCommand())
+
+	return configCmd
+}
+
+// configOptional lets a config subcommand run before the config file exists
+var configOptional = map[string]string{
+	util.ConfigOptionalAnnotation: "",
+}
+
+// configOptionalTableOnly also rejects --output formats other than table, for
+// the config subcommands that only print text
+var configOptionalTableOnly = map[string]string{
+	util.ConfigOptionalAnnotation:  "",
+	util.TableOutputOnlyAnnotation: "",
+}
+
+// lookupKey returns the configuration key with the given name, accepting the
+// flag name (e.g. default-board-id) as well
+func lookupKey(name string) (util.ConfigKey, error) {
+	configKey, ok := util.LookupConfigKey(strings.ReplaceAll(name, "-", "_"))
+	if !ok {
+		return util.ConfigKey{}, fmt.Errorf("unknown key '%s', must be one of: %s", name, strings.Join(util.ConfigKeyNames(), ", "))
+	}
+
+	return configKey, nil
+}
+
+// describeSource returns where the value of the key comes from, naming the flag,
+// environment variable, or config file
+func describeSource(configKey util.ConfigKey) string {
+	switch source := util.ConfigValueSource(configKey.Key); source {
+	case util.SourceFlag:
+		return fmt.Sprintf("flag --%s", configKey.FlagName())
+	case util.SourceEnv:
+		for _, envVar := range configKey.Env() {
+			if os.Getenv(envVar) != "" {
+				return fmt.Sprintf("env %s", envVar)
+			}
+		}
+		return source
+	case util.SourceRepo:
+		return fmt.Sprintf("repo file %s", util.RepoConfigFileUsed())
+	case util.SourceFile:
+		return fmt.Sprintf("file %s (profile '%s')", viper.ConfigFileUsed(), util.ActiveProfile())
+	default:
+		return "not set"
+	}
+}
+
+// displayValue returns the value of the key, with secrets redacted unless
+// reveal is set
+func displayValue(configKey util.ConfigKey, reveal bool) string {
+	switch configKey.Type {
+	case util.KeyTypeList:
+		return strings.Join(util.GetStringList(configKey.Key), ",")
+	case util.KeyTypeMap:
+		// only list the names, the values don't fit on a line
+		names := slices.Sorted(maps.Keys(viper.GetStringMap(string(configKey.Key))))
+		return strings.Join(names, ",")
+	}
+
+	value := viper.GetString(string(configKey.Key))
+	if !configKey.Secret || reveal || value == "" {
+		return value
+	}
+
+	if util.IsEncryptedToken(value) {
+		return "(encrypted)"
+	}
+
+	return util.SensorString(value)
+}
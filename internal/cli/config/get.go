@@ -0,0 +1,68 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var getReveal bool
+
+func newGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get KEY",
+		Short: "Print a configuration value",
+		Long: `Print the value of a configuration key for the active profile, with flags and environment variables applied.
+
+The value is printed on stdout so that it can be used in scripts, and where it comes from is printed on stderr. Secrets are redacted unless --reveal is set.`,
+		Args: cobra.ExactArgs(1),
+		Example: `# Print the Jira domain
+jira config get domain
+
+# Print the API token in full
+jira config get token --reveal`,
+		Annotations: configOptionalTableOnly,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return getValue(args[0])
+		},
+	}
+
+	cmd.Flags().BoolVar(&getReveal, "reveal", false, "print secrets in full")
+
+	return cmd
+}
+
+func getValue(name string) error {
+	configKey, err := lookupKey(name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(displayValue(configKey, getReveal))
+	fmt.Fprintf(os.Stderr, "source: %s\n", describeSource(configKey))
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"fmt"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+)
+
+func newSetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set KEY VALUE",
+		Short: "Set a configuration value",
+		Long:  `Save the value of a configuration key in the active profile of the config file, creating the file if it doesn't exist. List values, like 'default_labels', are comma-separated.`,
+		Args:  cobra.ExactArgs(2),
+		Example: `# Set the default board
+jira config set default_board_id 42
+
+# Read the API token from a password manager
+jira config set token_command "pass show jira/token"`,
+		Annotations: configOptionalTableOnly,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return setValue(args[0], args[1])
+		},
+	}
+
+	return cmd
+}
+
+func setValue(name string, value string) error {
+	configKey, err := lookupKey(name)
+	if err != nil {
+		return err
+	}
+
+	if configKey.Type == util.KeyTypeMap {
+		return fmt.Errorf("'%s' can't be set from the command line, edit the config file instead", configKey.Key)
+	}
+	if err := configKey.Validate(value); err != nil {
+		return err
+	}
+
+	// check where the current value comes from before it's overwritten
+	source := util.ConfigValueSource(configKey.Key)
+	if err := util.SaveProfileValues(map[util.ViperKey]any{configKey.Key: configKey.Parse(value)}); err != nil {
+		return fmt.Errorf("failed to save '%s': %s", configKey.Key, err)
+	}
+
+	fmt.Printf("Set '%s' in profile '%s'.\n", configKey.Key, util.ActiveProfile())
+	if source == util.SourceFlag || source == util.SourceEnv || source == util.SourceRepo {
+		fmt.Printf("Note: the value is overridden by the %s.\n", describeSource(configKey))
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"fmt"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+)
+
+func newUnsetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unset KEY",
+		Short: "Remove a configuration value",
+		Long:  `Remove a configuration key from the active profile of the config file.`,
+		Args:  cobra.ExactArgs(1),
+		Example: `# Stop using a default board
+jira config unset default_board_id`,
+		Annotations: configOptionalTableOnly,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return unsetValue(args[0])
+		},
+	}
+
+	return cmd
+}
+
+func unsetValue(name string) error {
+	configKey, err := lookupKey(name)
+	if err != nil {
+		return err
+	}
+
+	removed, err := util.UnsetProfileValue(configKey.Key)
+	if err != nil {
+		return fmt.Errorf("failed to unset '%s': %s", configKey.Key, err)
+	}
+
+	if !removed {
+		fmt.Printf("'%s' isn't set in profile '%s'.\n", configKey.Key, util.ActiveProfile())
+		return nil
+	}
+	fmt.Printf("Unset '%s' in profile '%s'.\n", configKey.Key, util.ActiveProfile())
+
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package config
+
+import (
+	"fmt"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	viewAll    bool
+	viewReveal bool
+)
+
+func newViewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Show the configuration",
+		Long:  `Show the configuration values of the active profile, with the repository config file, flags, and environment variables applied, and where each value comes from. Secrets are redacted unless --reveal is set.`,
+		Args:  cobra.NoArgs,
+		Example: `# Show the configured values
+jira config view
+
+# Show every key, including the ones that aren't set
+jira config view --all`,
+		Annotations: configOptional,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return viewConfig()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&viewAll, "all", "a", false, "also show keys that aren't set")
+	cmd.Flags().BoolVar(&viewReveal, "reveal", false, "show secrets in full")
+
+	return cmd
+}
+
+// configValue is a configuration value and where it comes from
+type configValue struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source string `json:"source"`
+}
+
+// configValueColumns are the columns of the configuration values
+var configValueColumns = []util.Column[configValue]{
+	{Header: "Key", Field: "key", Value: func(value configValue) string { return value.Key }},
+	{Header: "Value", Field: "value", Value: func(value configValue) string { return value.Value }},
+	{Header: "Source", Field: "source", Value: func(value configValue) string { return value.Source }},
+}
+
+func viewConfig() error {
+	values := []configValue{}
+	for _, configKey := range util.ConfigKeys {
+		if !viewAll && util.ConfigValueSource(configKey.Key) == util.SourceDefault {
+			continue
+		}
+		values = append(values, configValue{
+			Key:    string(configKey.Key),
+			Value:  displayValue(configKey, viewReveal),
+			Source: describeSource(configKey),
+		})
+	}
+
+	if util.IsTableOutput() {
+		fmt.Printf("Profile: %s\n", util.ActiveProfile())
+		fmt.Printf("Config file: %s\n", viper.ConfigFileUsed())
+		if repoConfigFile := util.RepoConfigFileUsed(); repoConfigFile != "" {
+			fmt.Printf("Repository config file: %s\n", repoConfigFile)
+		}
+		fmt.Println()
+	}
+
+	// print out configuration values
+	if err := util.PrintList(values, configValueColumns); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -23,11 +23,15 @@ package configure
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/eeternalsadness/jira/internal/util"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
+var nonInteractive bool
+
 var configurationOptions = []string{
 	"Credentials",
 	"Default issue type",
@@ -39,7 +43,24 @@ func NewCommand() *cobra.Command {
 	configureCmd := &cobra.Command{
 		Use:   "configure",
 		Short: "Configure credentials, issue types, or projects for the CLI tool",
+		Long: `Configure the Jira credentials, default project, and default issue type of the active profile interactively.
+
+With --non-interactive, the settings passed in with global flags are saved without prompting, e.g. to provision the config file in scripts.`,
+		Example: `# Configure interactively
+jira configure
+
+# Provision the config file without prompting
+jira configure --non-interactive --domain example.atlassian.net --email me@example.com --token-command "pass show jira"`,
+		Annotations: map[string]string{
+			util.ConfigOptionalAnnotation:  "",
+			util.TableOutputOnlyAnnotation: "",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if nonInteractive {
+				cmd.SilenceUsage = true
+				return configureNonInteractive()
+			}
+
 			configurationOption, err := selectConfigOption()
 			if err != nil || configurationOption == "" {
 				return err
@@ -58,9 +79,45 @@ func NewCommand() *cobra.Command {
 		},
 	}
 
+	configureCmd.Flags().BoolVar(&nonInteractive, "non-interactive", false, "save the settings passed in with global flags (e.g. --domain, --email) without prompting")
+
 	return configureCmd
 }
 
+// configureNonInteractive saves the settings passed in with global flags to the
+// active profile. Settings from environment variables aren't saved, so that
+// secrets in the environment don't end up in the config file.
+func configureNonInteractive() error {
+	values := util.ChangedConfigFlags()
+	if len(values) == 0 {
+		return fmt.Errorf("no settings passed in, use global flags such as --domain, --email, and --token")
+	}
+
+	keys := make([]string, 0, len(values))
+	toSave := map[util.ViperKey]any{}
+	for key, value := range values {
+		keys = append(keys, string(key))
+		toSave[key] = value
+	}
+	sort.Strings(keys)
+
+	if err := util.SaveProfileValues(toSave); err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved to profile '%s' in '%s':\n", util.ActiveProfile(), viper.ConfigFileUsed())
+	for _, key := range keys {
+		configKey, _ := util.LookupConfigKey(key)
+		value := values[util.ViperKey(key)]
+		if configKey.Secret {
+			value = util.SensorString(value)
+		}
+		fmt.Printf("  %s: %s\n", key, value)
+	}
+
+	return nil
+}
+
 func selectConfigOption() (string, error) {
 	fmt.Println("Configuration options:")
 	err := util.PrettyPrintStringSlice(configurationOptions)
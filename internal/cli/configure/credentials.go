@@ -39,21 +39,40 @@ func configureCredentials() error {
 		return err
 	}
 
+	// the token isn't stored in the config file when it's read from elsewhere
+	if util.HasTokenHelper() {
+		fmt.Printf("The Jira API token is read from '%s', '%s', or '%s', skipping it.\n", util.JiraTokenEnvKey, util.JiraTokenFileKey, util.JiraTokenCommandKey)
+		return util.ConfigJiraCredentials(domain, email, nil)
+	}
+
 	// configure jira api token
-	defaultToken := "example.atlassian.net"
-	defaultTokenSensored := defaultToken
+	defaultToken := ""
+	defaultTokenSensored := "none"
 	tokenKey := string(util.JiraTokenKey)
 	if viper.IsSet(tokenKey) {
 		defaultToken = viper.GetString(tokenKey)
 		defaultTokenSensored = util.SensorString(defaultToken)
+		if util.IsEncryptedToken(defaultToken) {
+			defaultTokenSensored = "encrypted"
+		}
 	}
-	token, err := util.UserGetString(
-		fmt.Sprintf("Enter the Jira API token [%s]: ", defaultTokenSensored),
-		&defaultToken,
-		false)
+	token, err := util.UserGetSecret(fmt.Sprintf("Enter the Jira API token [%s]: ", defaultTokenSensored))
+	if err != nil {
+		return err
+	}
+	if token == "" && defaultToken == "" {
+		return fmt.Errorf("the Jira API token can't be empty")
+	}
+	if token == "" {
+		// keep the existing token as is, encrypted or not
+		return util.ConfigJiraCredentials(domain, email, &defaultToken)
+	}
+
+	// optionally encrypt the token at rest
+	token, err = util.PromptTokenEncryption(token)
 	if err != nil {
 		return err
 	}
 
-	return util.ConfigJiraCredentials(domain, email, token)
+	return util.ConfigJiraCredentials(domain, email, &token)
 }
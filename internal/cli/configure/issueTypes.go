@@ -7,27 +7,64 @@ import (
 	"github.com/spf13/viper"
 )
 
-// TODO: make the user search for default issue type using name instead
 func configureDefaultIssueType() error {
-	var defaultVal *string = nil
-	defaultValStr := ""
-	defaultIssueTypeIDKey := "default_issue_type_id"
+	jiraClient, err := util.InitJiraConfig()
+	if err != nil {
+		return err
+	}
+	if jiraClient.Domain == "" {
+		return fmt.Errorf("no Jira credentials are configured, configure the credentials first")
+	}
+
+	// issue types depend on the project
+	projectID := viper.GetString(string(util.DefaultProjectIDKey))
+	if projectID == "" {
+		return fmt.Errorf("no default project is configured, configure the default project first")
+	}
+
+	// show the current default issue type, if any
+	if viper.IsSet(string(util.DefaultIssueTypeIDKey)) {
+		fmt.Printf("Current default issue type: %s\n", describeDefault(util.DefaultIssueTypeNameKey, util.DefaultIssueTypeIDKey))
+	}
+
+	issueTypes, err := jiraClient.GetProjectIssueTypes(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get issue types of project %s: %s", describeDefault(util.DefaultProjectKeyKey, util.DefaultProjectIDKey), err)
+	}
+	if len(issueTypes) == 0 {
+		return fmt.Errorf("no issue types found in project %s", describeDefault(util.DefaultProjectKeyKey, util.DefaultProjectIDKey))
+	}
 
-	// if there is an existing value, use it as the default issue type ID
-	if viper.IsSet(defaultIssueTypeIDKey) {
-		*defaultVal = viper.GetString(defaultIssueTypeIDKey)
-		defaultValStr = fmt.Sprintf(" [%s]", *defaultVal)
+	options := make([]string, len(issueTypes))
+	for i, issueType := range issueTypes {
+		options[i] = fmt.Sprintf("%s\t%s\t%s", issueType.Name, issueTypeKind(issueType.HierarchyLevel, issueType.Subtask), issueType.ID)
 	}
 
-	defaultIssueTypeID, err := util.UserGetString(
-		fmt.Sprintf("Enter the default issue type ID%s: ", defaultValStr),
-		defaultVal,
-		false)
+	index, err := util.UserSearchSelect("Name\tType\tID", options)
 	if err != nil {
 		return err
 	}
+	issueType := issueTypes[index]
 
-	viper.Set(defaultIssueTypeIDKey, *defaultIssueTypeID)
+	err = util.SaveProfileValues(map[util.ViperKey]any{
+		util.DefaultIssueTypeIDKey:   issueType.ID,
+		util.DefaultIssueTypeNameKey: issueType.Name,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Default issue type set to %s.\n", issueType.Name)
+
+	return nil
+}
 
-	return viper.WriteConfig()
+func issueTypeKind(hierarchyLevel int, isSubtask bool) string {
+	switch {
+	case isSubtask || hierarchyLevel < 0:
+		return "sub-task"
+	case hierarchyLevel > 0:
+		return "epic"
+	default:
+		return "standard"
+	}
 }
@@ -2,32 +2,82 @@ package configure
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/eeternalsadness/jira/internal/util"
 	"github.com/spf13/viper"
 )
 
-// TODO: make the user search for projects using keys instead
 func configureDefaultProject() error {
-	var defaultVal *string = nil
-	defaultValStr := ""
-	defaultProjectIDKey := "default_project_id"
+	jiraClient, err := util.InitJiraConfig()
+	if err != nil {
+		return err
+	}
+	if jiraClient.Domain == "" {
+		return fmt.Errorf("no Jira credentials are configured, configure the credentials first")
+	}
+
+	// show the current default project, if any
+	if viper.IsSet(string(util.DefaultProjectIDKey)) {
+		fmt.Printf("Current default project: %s\n", describeDefault(util.DefaultProjectKeyKey, util.DefaultProjectIDKey))
+	}
+
+	projects, err := jiraClient.ListProjects()
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %s", err)
+	}
+	if len(projects) == 0 {
+		return fmt.Errorf("no projects found, make sure your account has access to at least one project")
+	}
+
+	options := make([]string, len(projects))
+	for i, project := range projects {
+		options[i] = fmt.Sprintf("%s\t%s\t%s", project.Key, project.Name, project.ProjectType)
+	}
 
-	// if there is an existing value, use it as the default project ID
-	if viper.IsSet(defaultProjectIDKey) {
-		*defaultVal = viper.GetString(defaultProjectIDKey)
-		defaultValStr = fmt.Sprintf(" [%s]", *defaultVal)
+	index, err := util.UserSearchSelect("Key\tName\tType", options)
+	if err != nil {
+		return err
 	}
+	project := projects[index]
 
-	defaultProjectID, err := util.UserGetString(
-		fmt.Sprintf("Enter the default project ID%s: ", defaultValStr),
-		defaultVal,
-		false)
+	err = util.SaveProfileValues(map[util.ViperKey]any{
+		util.DefaultProjectIDKey:  project.ID,
+		util.DefaultProjectKeyKey: project.Key,
+	})
 	if err != nil {
 		return err
 	}
+	fmt.Printf("Default project set to %s (%s).\n", project.Key, project.Name)
 
-	viper.Set(defaultProjectIDKey, *defaultProjectID)
+	// the default issue type may not be available in the new project
+	issueTypeID := viper.GetString(string(util.DefaultIssueTypeIDKey))
+	if issueTypeID == "" {
+		return nil
+	}
+	issueTypes, err := jiraClient.GetProjectIssueTypes(project.ID)
+	if err != nil {
+		return nil
+	}
+	for _, issueType := range issueTypes {
+		if issueType.ID == issueTypeID {
+			return nil
+		}
+	}
+	fmt.Printf("The default issue type %s isn't available in %s, run 'jira configure' to pick another one.\n",
+		describeDefault(util.DefaultIssueTypeNameKey, util.DefaultIssueTypeIDKey), project.Key)
+
+	return nil
+}
+
+// describeDefault formats a configured default as its name and ID, or only its
+// ID if the name wasn't stored
+func describeDefault(nameKey util.ViperKey, idKey util.ViperKey) string {
+	name := strings.TrimSpace(viper.GetString(string(nameKey)))
+	id := viper.GetString(string(idKey))
+	if name == "" {
+		return fmt.Sprintf("ID %s", id)
+	}
 
-	return viper.WriteConfig()
+	return fmt.Sprintf("%s (ID %s)", name, id)
 }
@@ -0,0 +1,357 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package doctor
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const networkTimeout = 10 * time.Second
+
+// permissions needed to get, create, and transition issues
+var requiredPermissions = []string{"BROWSE_PROJECTS", "CREATE_ISSUES", "TRANSITION_ISSUES", "ASSIGN_ISSUES"}
+
+// NewCommand creates and returns the doctor command
+func NewCommand() *cobra.Command {
+	doctorCmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose problems with the configuration and the connection to Jira",
+		Long: `Run a checklist to find out whether a problem comes from the configuration, the credentials, the network, or permissions.
+
+The checks cover the config file and its keys, the credentials, DNS resolution and the TLS handshake with the Jira domain, authentication, the server type and version, the default project and issue type, and the permissions needed to create and transition issues. Each check prints a pass or fail line, with a hint on how to fix failures. The command exits with a non-zero status if any check fails.`,
+		Args: cobra.NoArgs,
+		Example: `# Check the configuration and the connection to Jira
+jira doctor
+
+# Check another profile
+jira doctor --profile client`,
+		Annotations: map[string]string{
+			util.ConfigOptionalAnnotation:  "",
+			util.TableOutputOnlyAnnotation: "",
+		},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// override the root's PersistentPreRunE since config errors are reported as checks
+			return util.ValidateOutputFormat(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return runDoctor(cmd)
+		},
+	}
+
+	return doctorCmd
+}
+
+// report prints the result of each check and keeps count of the results
+type report struct {
+	passed   int
+	warnings int
+	failed   int
+}
+
+func (r *report) pass(name string, detail string) {
+	r.passed++
+	r.print(util.Colorize("[ OK ]", util.ColorGreen), name, detail, "")
+}
+
+func (r *report) warn(name string, detail string, hint string) {
+	r.warnings++
+	r.print(util.Colorize("[WARN]", util.ColorYellow), name, detail, hint)
+}
+
+func (r *report) fail(name string, detail string, hint string) {
+	r.failed++
+	r.print(util.Colorize("[FAIL]", util.ColorRed), name, detail, hint)
+}
+
+func (r *report) skip(name string, reason string) {
+	r.print(util.Colorize("[SKIP]", util.ColorGray), name, reason, "")
+}
+
+func (r *report) print(status string, name string, detail string, hint string) {
+	fmt.Printf("%s %s: %s\n", status, name, detail)
+	if hint != "" {
+		fmt.Printf("       hint: %s\n", hint)
+	}
+}
+
+func runDoctor(cmd *cobra.Command) error {
+	r := &report{}
+
+	jiraClient, ok := checkConfig(cmd, r)
+	if ok {
+		ok = checkNetwork(jiraClient.Domain, r)
+	} else {
+		r.skip("Network", "the configuration has errors")
+	}
+	if ok {
+		ok = checkAuthentication(&jiraClient, r)
+	} else {
+		r.skip("Authentication", "the Jira domain can't be reached")
+	}
+	if ok {
+		checkServer(&jiraClient, r)
+		checkDefaults(&jiraClient, r)
+	} else {
+		r.skip("Server", "not authenticated")
+		r.skip("Defaults", "not authenticated")
+	}
+
+	fmt.Printf("\n%d passed, %d warning(s), %d failed\n", r.passed, r.warnings, r.failed)
+	if r.failed > 0 {
+		return fmt.Errorf("%d check(s) failed", r.failed)
+	}
+
+	return nil
+}
+
+// checkConfig checks the config file and the credentials, and returns a Jira
+// client if they can be used
+func checkConfig(cmd *cobra.Command, r *report) (jira.Jira, bool) {
+	cfgFile, _ := cmd.Flags().GetString("config")
+	profile, _ := cmd.Flags().GetString("profile")
+
+	if err := util.InitConfig(cmd, cfgFile, profile); err != nil {
+		r.fail("Config file", err.Error(), "fix the syntax of the config file, or pick an existing profile with --profile")
+		return jira.Jira{}, false
+	}
+
+	configFilePath := viper.ConfigFileUsed()
+	if _, err := os.Stat(configFilePath); errors.Is(err, os.ErrNotExist) {
+		if viper.GetString(string(util.JiraDomainKey)) == "" {
+			r.fail("Config file", fmt.Sprintf("'%s' not found", configFilePath), "run 'jira configure', or set JIRA_DOMAIN, JIRA_EMAIL, and JIRA_API_TOKEN")
+			return jira.Jira{}, false
+		}
+		r.pass("Config file", fmt.Sprintf("'%s' not found, using environment variables and flags", configFilePath))
+	} else {
+		configFile, err := util.ReadConfigFile()
+		if err != nil {
+			r.fail("Config file", err.Error(), "fix the syntax of the config file")
+			return jira.Jira{}, false
+		}
+
+		if unknownKeys := configFile.UnknownKeys(); len(unknownKeys) > 0 {
+			r.warn("Config file", fmt.Sprintf("'%s' has unknown keys: %s", configFilePath, strings.Join(unknownKeys, ", ")), "check the keys for typos, they are ignored")
+		} else {
+			r.pass("Config file", fmt.Sprintf("'%s' is valid", configFilePath))
+		}
+	}
+	r.pass("Profile", fmt.Sprintf("using profile '%s'", util.ActiveProfile()))
+	if repoConfigFile := util.RepoConfigFileUsed(); repoConfigFile != "" {
+		r.pass("Repository config file", fmt.Sprintf("using '%s'", repoConfigFile))
+	}
+
+	// credentials
+	var missing []string
+	for _, key := range []util.ViperKey{util.JiraDomainKey, util.JiraEmailKey} {
+		if viper.GetString(string(key)) == "" {
+			missing = append(missing, string(key))
+		}
+	}
+	if viper.GetString(string(util.JiraTokenKey)) == "" && !util.HasTokenHelper() {
+		missing = append(missing, string(util.JiraTokenKey))
+	}
+	if len(missing) > 0 {
+		r.fail("Credentials", fmt.Sprintf("missing %s", strings.Join(missing, ", ")), "run 'jira configure' and pick 'Credentials'")
+		return jira.Jira{}, false
+	}
+
+	jiraClient, err := util.InitJiraConfig()
+	if err != nil {
+		r.fail("Credentials", err.Error(), "check the 'token_command', 'token_file', or 'token_env' setting, or the passphrase of the encrypted token")
+		return jira.Jira{}, false
+	}
+	r.pass("Credentials", fmt.Sprintf("domain '%s', email '%s', token from %s", jiraClient.Domain, jiraClient.Email, tokenSource()))
+
+	return jiraClient, true
+}
+
+func tokenSource() string {
+	if source := util.ConfigValueSource(util.JiraTokenKey); source == util.SourceFlag || source == util.SourceEnv {
+		return source
+	}
+
+	for _, key := range []util.ViperKey{util.JiraTokenEnvKey, util.JiraTokenFileKey, util.JiraTokenCommandKey} {
+		if viper.GetString(string(key)) != "" {
+			return fmt.Sprintf("'%s'", key)
+		}
+	}
+
+	if util.IsEncryptedToken(viper.GetString(string(util.JiraTokenKey))) {
+		return "the config file (encrypted)"
+	}
+
+	return "the config file"
+}
+
+// checkNetwork resolves the Jira domain and performs a TLS handshake with it
+func checkNetwork(domain string, r *report) bool {
+	host, port, err := net.SplitHostPort(domain)
+	if err != nil {
+		host, port = domain, "443"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), networkTimeout)
+	defer cancel()
+	addresses, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		r.fail("DNS", fmt.Sprintf("failed to resolve '%s': %s", host, err), "check the Jira domain (e.g. example.atlassian.net, without 'https://'), your network connection, or your proxy/VPN")
+		return false
+	}
+	r.pass("DNS", fmt.Sprintf("'%s' resolves to %s", host, strings.Join(addresses, ", ")))
+
+	dialer := &net.Dialer{Timeout: networkTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(host, port), &tls.Config{ServerName: host})
+	if err != nil {
+		r.fail("TLS", fmt.Sprintf("handshake with '%s' failed: %s", host, err), "check that the domain serves HTTPS and that no proxy intercepts the connection with an untrusted certificate")
+		return false
+	}
+	defer conn.Close()
+
+	state := conn.ConnectionState()
+	detail := fmt.Sprintf("handshake with '%s' succeeded (%s)", host, tls.VersionName(state.Version))
+	if len(state.PeerCertificates) > 0 {
+		expiry := state.PeerCertificates[0].NotAfter
+		detail += fmt.Sprintf(", certificate valid until %s", expiry.Format("2006-01-02"))
+	}
+	r.pass("TLS", detail)
+
+	return true
+}
+
+func checkAuthentication(jiraClient *jira.Jira, r *report) bool {
+	user, err := jiraClient.GetCurrentUser()
+	if err != nil {
+		r.fail("Authentication", firstLine(err.Error()), "check the email and API token; API tokens for Jira Cloud are created at https://id.atlassian.com/manage-profile/security/api-tokens")
+		return false
+	}
+
+	account := user.DisplayName
+	if user.Email != "" {
+		account += fmt.Sprintf(" <%s>", user.Email)
+	}
+	if !user.Active {
+		r.fail("Authentication", fmt.Sprintf("signed in as %s, but the account is inactive", account), "ask a Jira administrator to reactivate the account")
+		return false
+	}
+	r.pass("Authentication", fmt.Sprintf("signed in as %s (account ID %s)", account, user.AccountID))
+
+	return true
+}
+
+func checkServer(jiraClient *jira.Jira, r *report) {
+	serverInfo, err := jiraClient.GetServerInfo()
+	if err != nil {
+		r.warn("Server", firstLine(err.Error()), "the server type and version can't be read, some commands may not work")
+		return
+	}
+
+	detail := fmt.Sprintf("Jira %s, version %s", serverInfo.DeploymentType, serverInfo.Version)
+	if serverInfo.DeploymentType != "Cloud" {
+		r.warn("Server", detail, "this CLI targets Jira Cloud (REST API v3), some commands may not work with Jira Server or Data Center")
+		return
+	}
+	r.pass("Server", detail)
+}
+
+// checkDefaults checks that the default project and issue type exist, and that
+// issues can be created and transitioned in the default project
+func checkDefaults(jiraClient *jira.Jira, r *report) {
+	projectID := viper.GetString(string(util.DefaultProjectIDKey))
+	if projectID == "" {
+		r.warn("Default project", "not configured", "run 'jira configure' and pick 'Default project', or pass the project to each command")
+		r.skip("Default issue type", "no default project")
+		checkPermissions(jiraClient, "", r)
+		return
+	}
+
+	project, err := jiraClient.GetProject(projectID)
+	if err != nil {
+		r.fail("Default project", fmt.Sprintf("project %s not found or not visible: %s", projectID, firstLine(err.Error())), "run 'jira configure' and pick another default project")
+		r.skip("Default issue type", "the default project can't be read")
+		return
+	}
+	r.pass("Default project", fmt.Sprintf("%s (%s)", project.Key, project.Name))
+
+	issueTypeID := viper.GetString(string(util.DefaultIssueTypeIDKey))
+	if issueTypeID == "" {
+		r.warn("Default issue type", "not configured", "run 'jira configure' and pick 'Default issue type', or pass --type to 'jira issue create'")
+	} else if issueTypes, err := jiraClient.GetProjectIssueTypes(project.ID); err != nil {
+		r.fail("Default issue type", firstLine(err.Error()), "check that you can create issues in the project")
+	} else {
+		found := false
+		for _, issueType := range issueTypes {
+			if issueType.ID == issueTypeID {
+				r.pass("Default issue type", fmt.Sprintf("%s (ID %s)", issueType.Name, issueType.ID))
+				found = true
+				break
+			}
+		}
+		if !found {
+			r.fail("Default issue type", fmt.Sprintf("issue type %s can't be created in %s", issueTypeID, project.Key), fmt.Sprintf("run 'jira configure' and pick 'Default issue type', or 'jira project issue-types %s' to list them", project.Key))
+		}
+	}
+
+	checkPermissions(jiraClient, project.ID, r)
+}
+
+func checkPermissions(jiraClient *jira.Jira, projectID string, r *report) {
+	permissions, err := jiraClient.GetMyPermissions(projectID, requiredPermissions)
+	if err != nil {
+		r.warn("Permissions", firstLine(err.Error()), "the permissions can't be read")
+		return
+	}
+
+	var missing []string
+	for _, permission := range requiredPermissions {
+		if !permissions[permission] {
+			missing = append(missing, permission)
+		}
+	}
+
+	scope := "in the default project"
+	if projectID == "" {
+		scope = "in at least one project"
+	}
+	if len(missing) > 0 {
+		r.fail("Permissions", fmt.Sprintf("missing %s %s", strings.Join(missing, ", "), scope), "ask a Jira administrator to grant them through the project's permission scheme")
+		return
+	}
+	r.pass("Permissions", fmt.Sprintf("can browse, create, assign, and transition issues %s", scope))
+}
+
+func firstLine(str string) string {
+	line, _, _ := strings.Cut(str, "\n")
+	return line
+}
@@ -0,0 +1,134 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package issue
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultBranchTemplate = "{{ .Key }}-{{ .Slug }}"
+	maxSlugLength         = 50
+)
+
+// branchData is the data available to the branch name template
+type branchData struct {
+	Key     string
+	Title   string
+	Slug    string
+	Type    string
+	Project string
+}
+
+func newBranchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branch ISSUE_ID",
+		Short: "Print a git branch name for a Jira issue",
+		Long: `Print a git branch name for a Jira issue, built from the 'branch_template' setting, which is usually set per repository in '.jira.yaml'.
+
+The template is a Go template with the fields .Key (e.g. PROJ-123), .Title, .Slug (the title in lower case with dashes, at most 50 characters), .Type (the issue type in lower case with dashes, e.g. bug), and .Project (the project key). The default template is '` + defaultBranchTemplate + `'.`,
+		Args: cobra.ExactArgs(1),
+		Example: `# Print the branch name for an issue
+jira issue branch PROJ-123
+
+# Create the branch and switch to it
+git switch -c "$(jira issue branch PROJ-123)"`,
+		Annotations: map[string]string{
+			util.TableOutputOnlyAnnotation: "",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return printBranchName(args[0])
+		},
+	}
+
+	return cmd
+}
+
+func printBranchName(issueID string) error {
+	branchTemplate := viper.GetString(string(util.BranchTemplateKey))
+	if branchTemplate == "" {
+		branchTemplate = defaultBranchTemplate
+	}
+
+	tmpl, err := template.New(string(util.BranchTemplateKey)).Option("missingkey=error").Parse(branchTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse '%s': %s", util.BranchTemplateKey, err)
+	}
+
+	issue, err := jiraClient.GetIssueByID(issueID)
+	if err != nil {
+		return fmt.Errorf("failed to get Jira issue: %s", err)
+	}
+
+	var builder strings.Builder
+	err = tmpl.Execute(&builder, branchData{
+		Key:     issue.Key,
+		Title:   issue.Title,
+		Slug:    slugify(issue.Title, maxSlugLength),
+		Type:    slugify(issue.IssueType, maxSlugLength),
+		Project: issue.ProjectKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render '%s': %s", util.BranchTemplateKey, err)
+	}
+
+	fmt.Println(builder.String())
+	return nil
+}
+
+// slugify lowercases the string and replaces everything but letters and digits
+// with single dashes, cutting it at a dash to at most maxLength characters
+func slugify(str string, maxLength int) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(str) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && builder.Len() > 0 {
+				builder.WriteRune('-')
+			}
+			builder.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	slug := []rune(builder.String())
+	if len(slug) <= maxLength {
+		return string(slug)
+	}
+
+	slug = slug[:maxLength]
+	if i := strings.LastIndex(string(slug), "-"); i > 0 {
+		return string(slug)[:i]
+	}
+
+	return string(slug)
+}
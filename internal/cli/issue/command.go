@@ -45,15 +45,25 @@ jira issue get PROJ-123
 jira issue create
 
 # Transition an issue
-jira issue transition PROJ-123`,
+jira issue transition PROJ-123
+
+# Move an issue to a status through several transitions
+jira issue move PROJ-123 --status "In Review"
+
+# Rank an issue at the top of the backlog
+jira issue rank PROJ-123 --top
+
+# Create issues in bulk from a file
+jira issue import plan.csv
+
+# Print a git branch name for an issue
+jira issue branch PROJ-123`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			var err error
-			if cmd.HasParent() {
-				if err = cmd.Parent().PersistentPreRunE(cmd.Parent(), args); err != nil {
-					return err
-				}
+			if err := util.RunRootPreRun(cmd, args); err != nil {
+				return err
 			}
 
+			var err error
 			jiraClient, err = util.InitJiraConfig()
 			if err != nil {
 				return err
@@ -67,6 +77,10 @@ jira issue transition PROJ-123`,
 	issueCmd.AddCommand(newGetCommand())
 	issueCmd.AddCommand(newCreateCommand())
 	issueCmd.AddCommand(newTransitionCommand())
+	issueCmd.AddCommand(newImportCommand())
+	issueCmd.AddCommand(newMoveCommand())
+	issueCmd.AddCommand(newBranchCommand())
+	issueCmd.AddCommand(newRankCommand())
 
 	return issueCmd
 }
@@ -28,48 +28,140 @@ import (
 	"strings"
 
 	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	projectID   string
-	issueTypeID string
+	projectID    string
+	issueTypeID  string
+	issueTypeArg string
+	templateName string
+	templateVars []string
 )
 
 func newCreateCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create [-p PROJECT_ID] [-t ISSUE_TYPE_ID]",
+		Use:   "create [-p PROJECT_ID] [-t ISSUE_TYPE_ID | --type NAME] [--template NAME]",
 		Short: "Create a Jira issue",
-		Long:  `Create a Jira issue in the specified project. The issue is assigned to the current user by default.`,
-		Args:  cobra.MaximumNArgs(2),
+		Long: `Create a Jira issue in the specified project. The issue is assigned to the current user by default.
+
+The issue type can be passed in by ID with --issue-type-id, or by name with --type, which is matched against the issue types of the project (case-insensitive, with prefix and fuzzy matching). Use 'jira project issue-types PROJECT' to list them.
+
+The labels and components in 'default_labels' and 'default_components' are added to the issue, unless a template sets its own.
+
+Issue templates are YAML ('.yaml', '.yml') or Markdown ('.md' with a YAML front matter) files named after the template. They are looked up in the 'templates_dir' directory if it's set, in '.jira/templates/' from the current directory upwards, then in the 'templates' folder next to the config file. A template can set the summary, project, issue type, labels, components, custom fields, and description. '{{ .Var }}' placeholders in the summary and description are prompted for unless passed in with --var.`,
+		Args: cobra.MaximumNArgs(2),
 		Example: `# Create a Jira issue with the default project and issue type
 jira issue create
 
 # Create a Jira issue with a specific project and issue type
-jira issue create --project-id 123 --issue-type-id 456`,
-		PreRun: func(cmd *cobra.Command, args []string) {
-			if projectID == "" {
-				projectID = viper.GetString(string(util.DefaultProjectIDKey))
-			}
+jira issue create --project-id 123 --issue-type-id 456
 
-			if issueTypeID == "" {
-				issueTypeID = viper.GetString(string(util.DefaultIssueTypeIDKey))
-			}
-		},
+# Create a bug in the default project
+jira issue create --type Bug
+
+# Create a Jira issue from the 'bug' template
+jira issue create --template bug
+
+# Create a Jira issue from a template without prompting for its variables
+jira issue create --template incident --var Service=payments --var Severity=SEV2`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			return createIssue()
+			if templateName != "" {
+				return createIssueFromTemplate(cmd)
+			}
+			return createIssue(cmd)
 		},
 	}
 
 	cmd.Flags().StringVarP(&projectID, "project-id", "p", "", "create an issue in the specified project")
 	cmd.Flags().StringVarP(&issueTypeID, "issue-type-id", "t", "", "specify the issue type to create")
+	cmd.Flags().StringVar(&issueTypeArg, "type", "", "specify the issue type to create by name (e.g. Bug)")
+	cmd.MarkFlagsMutuallyExclusive("issue-type-id", "type")
+	cmd.Flags().StringVar(&templateName, "template", "", "create the issue from the specified issue template")
+	cmd.Flags().StringArrayVar(&templateVars, "var", nil, "set a template variable as NAME=VALUE (can be repeated)")
 
 	return cmd
 }
 
-func createIssue() error {
+// createdIssue is an issue that was created, as printed
+type createdIssue struct {
+	Key string `json:"key"`
+	URL string `json:"url"`
+}
+
+// createdIssueColumns are the columns of a created issue
+var createdIssueColumns = []util.Column[createdIssue]{
+	{Header: "Key", Field: "key", Value: func(issue createdIssue) string { return issue.Key }},
+	{Header: "URL", Field: "url", Value: func(issue createdIssue) string { return issue.URL }},
+}
+
+// parseKeyValues parses the NAME=VALUE pairs passed in with the flag, splitting
+// each on the first '=' so that values can hold commas and '='
+func parseKeyValues(pairs []string, flag string) (map[string]string, error) {
+	values := map[string]string{}
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid %s '%s', must be NAME=VALUE", flag, pair)
+		}
+		values[strings.TrimSpace(name)] = value
+	}
+
+	return values, nil
+}
+
+// resolveProjectAndIssueType picks the project and issue type to use, in order
+// of precedence: command-line flags, the template's values, then the configured
+// defaults. An issue type passed in by name is resolved to its ID in the project.
+func resolveProjectAndIssueType(cmd *cobra.Command, templateProject string, templateIssueType string) (string, string, error) {
+	project := projectID
+	if !cmd.Flags().Changed("project-id") {
+		project = templateProject
+	}
+	if project == "" {
+		project = viper.GetString(string(util.DefaultProjectIDKey))
+	}
+
+	if project == "" {
+		return "", "", fmt.Errorf("missing project and no default project is configured")
+	}
+
+	if issueTypeArg != "" {
+		issueTypes, err := jiraClient.GetProjectIssueTypes(project)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get issue types of project '%s': %s", project, err)
+		}
+
+		issueType, err := util.MatchIssueType(issueTypes, issueTypeArg)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to find issue type in project '%s': %s", project, err)
+		}
+		return project, issueType.ID, nil
+	}
+
+	issueType := issueTypeID
+	if !cmd.Flags().Changed("issue-type-id") {
+		issueType = templateIssueType
+	}
+	if issueType == "" {
+		issueType = viper.GetString(string(util.DefaultIssueTypeIDKey))
+	}
+	if issueType == "" {
+		return "", "", fmt.Errorf("missing issue type and no default issue type is configured")
+	}
+
+	return project, issueType, nil
+}
+
+func createIssue(cmd *cobra.Command) error {
+	project, issueType, err := resolveProjectAndIssueType(cmd, "", "")
+	if err != nil {
+		return err
+	}
+
 	reader := bufio.NewReader(os.Stdin)
 
 	// prompt for issue's title
@@ -94,11 +186,102 @@ func createIssue() error {
 	description = description[:len(description)-1]
 
 	// create issue
-	issueKey, err := jiraClient.CreateIssue(projectID, issueTypeID, title, description)
+	issueKey, err := jiraClient.CreateIssueWithOptions(jira.CreateIssueOptions{
+		Project:     project,
+		IssueType:   issueType,
+		Title:       title,
+		Description: description,
+		Labels:      util.GetStringList(util.DefaultLabelsKey),
+		Components:  util.GetStringList(util.DefaultComponentsKey),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create Jira issue: %s", err)
+	}
+
+	issue := createdIssue{Key: issueKey, URL: fmt.Sprintf("https://%s/browse/%s", jiraClient.Domain, issueKey)}
+	return util.PrintItem(issue, createdIssueColumns, func() error {
+		fmt.Printf("Issue '%s' created.\nURL: %s.\n", title, issue.URL)
+		return nil
+	})
+}
+
+func createIssueFromTemplate(cmd *cobra.Command) error {
+	issueTemplate, err := util.LoadIssueTemplate(templateName)
+	if err != nil {
+		return err
+	}
+
+	project, issueType, err := resolveProjectAndIssueType(cmd, issueTemplate.Project, issueTemplate.IssueType)
+	if err != nil {
+		return err
+	}
+
+	// prompt for the template variables that weren't passed in
+	variableNames, err := issueTemplate.Variables()
+	if err != nil {
+		return err
+	}
+
+	variables, err := parseKeyValues(templateVars, "--var")
+	if err != nil {
+		return err
+	}
+	for _, name := range variableNames {
+		if _, ok := variables[name]; ok {
+			continue
+		}
+
+		value, err := util.UserGetString(fmt.Sprintf("Enter a value for '%s': ", name), nil, false)
+		if err != nil {
+			return fmt.Errorf("failed to read user input: %s", err)
+		}
+		variables[name] = *value
+	}
+
+	title, description, err := issueTemplate.Render(variables)
+	if err != nil {
+		return err
+	}
+
+	// prompt for the title if the template doesn't provide one
+	if title == "" {
+		userTitle, err := util.UserGetString("Enter the issue's title: ", nil, false)
+		if err != nil {
+			return fmt.Errorf("failed to read user input: %s", err)
+		}
+		title = strings.TrimSpace(*userTitle)
+	}
+	if title == "" {
+		return fmt.Errorf("issue's title can't be empty")
+	}
+
+	// the template's labels and components replace the configured defaults
+	labels := issueTemplate.Labels
+	if labels == nil {
+		labels = util.GetStringList(util.DefaultLabelsKey)
+	}
+	components := issueTemplate.Components
+	if components == nil {
+		components = util.GetStringList(util.DefaultComponentsKey)
+	}
+
+	// create issue
+	issueKey, err := jiraClient.CreateIssueWithOptions(jira.CreateIssueOptions{
+		Project:      project,
+		IssueType:    issueType,
+		Title:        title,
+		Description:  description,
+		Labels:       labels,
+		Components:   components,
+		CustomFields: issueTemplate.CustomFields,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create Jira issue: %s", err)
 	}
 
-	fmt.Printf("Issue '%s' created.\nURL: https://%s/browse/%s.\n", title, jiraClient.Domain, issueKey)
-	return nil
+	issue := createdIssue{Key: issueKey, URL: fmt.Sprintf("https://%s/browse/%s", jiraClient.Domain, issueKey)}
+	return util.PrintItem(issue, createdIssueColumns, func() error {
+		fmt.Printf("Issue '%s' created from template '%s'.\nURL: %s.\n", title, issueTemplate.Name, issue.URL)
+		return nil
+	})
 }
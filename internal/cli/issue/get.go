@@ -23,13 +23,17 @@ package issue
 
 import (
 	"fmt"
-	"os"
-	"text/tabwriter"
+	"strconv"
+	"strings"
 
+	"github.com/eeternalsadness/jira/internal/util"
 	"github.com/spf13/cobra"
 )
 
-var isAll bool
+var (
+	isAll        bool
+	sprintFilter string
+)
 
 func newGetCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -40,8 +44,23 @@ func newGetCommand() *cobra.Command {
 		Example: `# Get all your assigned issues
 jira issue get --all
 
+# Get your assigned issues in the current sprint
+jira issue get --all --sprint current
+
 # Get a specific issue by ID
-jira issue get PROJ-123`,
+jira issue get PROJ-123
+
+# Get the keys of your assigned issues, one per line
+jira issue get --all --output keys
+
+# Get a specific issue as JSON
+jira issue get PROJ-123 --output json
+
+# Print your assigned issues with a Go template
+jira issue get --all --format '{{ .Key }}\t{{ .Status | pad 12 }}\t{{ .Title | truncate 50 }}'
+
+# Print your assigned issues with the named template 'mine' from the 'formats' setting
+jira issue get --all --format @mine`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 			return getIssue(cmd, args)
@@ -49,6 +68,8 @@ jira issue get PROJ-123`,
 	}
 
 	cmd.Flags().BoolVarP(&isAll, "all", "a", false, "get all issues assigned to you")
+	cmd.Flags().StringVarP(&sprintFilter, "sprint", "s", "", "with --all, only get issues in the sprint: 'current' for open sprints, a sprint ID, or a sprint name")
+	util.AddFormatFlag(cmd)
 
 	return cmd
 }
@@ -56,33 +77,55 @@ jira issue get PROJ-123`,
 func getIssue(cmd *cobra.Command, args []string) error {
 	if isAll && len(args) > 0 {
 		return fmt.Errorf("cannot use --all with an issue ID")
+	} else if !isAll && sprintFilter != "" {
+		return fmt.Errorf("--sprint can only be used with --all")
 	} else if !isAll && len(args) == 0 {
 		cmd.Usage()
 		return fmt.Errorf("missing argument or flags")
-	} else if isAll {
-		issues, err := jiraClient.GetAssignedIssues()
+	}
+
+	if isAll {
+		jql := "assignee = currentuser() AND statuscategory != \"Done\""
+		if sprintFilter != "" {
+			jql += " AND " + sprintJQL(sprintFilter)
+		}
+
+		issues, err := jiraClient.SearchIssues(jql)
 		if err != nil {
 			return fmt.Errorf("failed to get assigned issues: %s", err)
 		}
 
-		// print out issues
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "ID\tIssue\tStatus\tStatus Category\t")
-		for _, issue := range issues {
-			fmt.Fprintf(w, "%s\t[%s] %s\t%s\t%s\t\n", issue.ID, issue.Key, issue.Title, issue.Status, issue.StatusCategory)
-		}
-		w.Flush()
-	} else {
-		issueID := args[0]
-		issue, err := jiraClient.GetIssueByID(issueID)
-		if err != nil {
-			return fmt.Errorf("failed to get assigned issue: %s", err)
+		if len(issues) == 0 && util.IsTableOutput() {
+			fmt.Println("No issues found.")
+			return nil
 		}
 
+		return util.PrintList(issues, util.IssueColumns)
+	}
+
+	issueID := args[0]
+	issue, err := jiraClient.GetIssueByID(issueID)
+	if err != nil {
+		return fmt.Errorf("failed to get assigned issue: %s", err)
+	}
+
+	return util.PrintItem(issue, util.IssueColumns, func() error {
 		// print out issue
 		fmt.Printf("[%s] %s\n\n", issue.Key, issue.Title)
 		fmt.Printf("Description:\n%s\n", issue.Description)
+		return nil
+	})
+}
+
+// sprintJQL forms the JQL clause that filters issues by sprint
+func sprintJQL(sprint string) string {
+	if sprint == "current" {
+		return "sprint in openSprints()"
+	}
+
+	if _, err := strconv.Atoi(sprint); err == nil {
+		return fmt.Sprintf("sprint = %s", sprint)
 	}
 
-	return nil
+	return fmt.Sprintf("sprint = \"%s\"", strings.ReplaceAll(sprint, "\"", "\\\""))
 }
@@ -0,0 +1,612 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package issue
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	importFormat      string
+	importProjectID   string
+	importIssueTypeID string
+	importParallel    int
+	importStateFile   string
+	isImportDryRun    bool
+	isImportResume    bool
+	isImportForce     bool
+)
+
+// importRow is a single issue to create, read from a row of the import file
+type importRow struct {
+	Number  int
+	Options jira.CreateIssueOptions
+}
+
+// importResult is the outcome of creating the issue for an import row
+type importResult struct {
+	Row   int    `json:"row"`
+	Key   string `json:"key,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func newImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Create Jira issues in bulk from a file",
+		Long: `Create Jira issues in bulk from a CSV, YAML, or JSON Lines file. Each row (or YAML list item, or JSON line) is one issue.
+
+Supported columns are 'summary' (or 'title'), 'description', 'project', 'issue_type', 'parent', 'labels', 'components', and any 'customfield_*' field. Labels and components in CSV cells are comma-separated. Custom field cells are sent as numbers for number fields, as JSON for cells that hold a JSON object or list (e.g. '{"value": "High"}'), and as text otherwise. Rows without a project or issue type use --project-id and --issue-type-id, then the configured defaults, and rows without labels or components use 'default_labels' and 'default_components'.
+
+Every row is validated before any issue is created: the file is checked first, then the projects, issue types, and parents of the rows are looked up in Jira. Issue types are matched by ID or by name within the project. The row-to-key mapping is saved to a state file so that an import that partially failed can be resumed with --resume. If the state file already exists, the import only runs with --resume, or with --force to start over and create every issue again.`,
+		Args: cobra.ExactArgs(1),
+		Example: `# Preview the issues that would be created from a spreadsheet export
+jira issue import plan.csv --dry-run
+
+# Create issues from a YAML file, 8 at a time
+jira issue import plan.yaml --parallel 8
+
+# Retry only the rows that failed in the previous run
+jira issue import plan.jsonl --resume`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return importIssues(args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&importFormat, "input-format", "", "format of the file: csv, yaml, or jsonl (default is based on the file extension)")
+	cmd.Flags().StringVarP(&importProjectID, "project-id", "p", "", "project for rows that don't specify one")
+	cmd.Flags().StringVarP(&importIssueTypeID, "issue-type-id", "t", "", "issue type for rows that don't specify one")
+	cmd.Flags().IntVar(&importParallel, "parallel", 4, "maximum number of issues created at the same time")
+	cmd.Flags().StringVar(&importStateFile, "state", "", "file that records the created issues (default is FILE.state.json)")
+	cmd.Flags().BoolVar(&isImportDryRun, "dry-run", false, "validate the file and show the issues without creating them")
+	cmd.Flags().BoolVar(&isImportResume, "resume", false, "skip the rows that were already created according to the state file")
+	cmd.Flags().BoolVar(&isImportForce, "force", false, "start over even if the state file exists, creating the issues again")
+	cmd.MarkFlagsMutuallyExclusive("resume", "force")
+
+	return cmd
+}
+
+func importIssues(filePath string) error {
+	if importParallel < 1 {
+		return fmt.Errorf("--parallel must be at least 1")
+	}
+	if importStateFile == "" {
+		importStateFile = filePath + ".state.json"
+	}
+
+	// the state file of a previous run would be overwritten, and its issues
+	// created again
+	if !isImportResume && !isImportForce {
+		if _, err := os.Stat(importStateFile); err == nil {
+			return fmt.Errorf("state file '%s' already exists, use --resume to skip the issues it records, or --force to create every issue again", importStateFile)
+		}
+	}
+
+	records, err := readImportFile(filePath)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("no rows found in '%s'", filePath)
+	}
+
+	fieldTypes, err := getImportFieldTypes(records)
+	if err != nil {
+		return err
+	}
+
+	// validate every row before creating anything
+	rows := make([]importRow, len(records))
+	var rowErrors []string
+	for i, record := range records {
+		rows[i], err = parseImportRow(i+1, record, fieldTypes)
+		if err != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("  row %d: %s", i+1, err))
+		}
+	}
+	if len(rowErrors) > 0 {
+		return fmt.Errorf("found %d invalid row(s), no issues were created:\n%s", len(rowErrors), strings.Join(rowErrors, "\n"))
+	}
+
+	// skip the rows that were created in a previous run
+	results := map[int]importResult{}
+	if isImportResume {
+		results, err = readImportState(importStateFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	var pending []importRow
+	for _, row := range rows {
+		if result, ok := results[row.Number]; ok && result.Key != "" {
+			continue
+		}
+		pending = append(pending, row)
+	}
+
+	// check the projects, issue types, and parents with Jira before creating anything
+	if rowErrors := checkImportRows(pending); len(rowErrors) > 0 {
+		return fmt.Errorf("found %d invalid row(s), no issues were created:\n%s", len(rowErrors), strings.Join(rowErrors, "\n"))
+	}
+
+	if isImportDryRun {
+		return printImportPlan(pending)
+	}
+
+	// assign every issue to the current user, looked up once for all rows
+	if len(pending) > 0 {
+		user, err := jiraClient.GetCurrentUser()
+		if err != nil {
+			return fmt.Errorf("failed to get the current user: %s", err)
+		}
+		for i := range pending {
+			pending[i].Options.Assignee = user.AccountID
+		}
+	}
+
+	for _, result := range createImportRows(pending) {
+		results[result.Row] = result
+	}
+
+	if err := writeImportState(importStateFile, results); err != nil {
+		return err
+	}
+
+	return printImportResults(rows, results)
+}
+
+func readImportFile(filePath string) ([]map[string]any, error) {
+	format := importFormat
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(filePath)) {
+		case ".csv":
+			format = "csv"
+		case ".yaml", ".yml":
+			format = "yaml"
+		case ".jsonl", ".ndjson":
+			format = "jsonl"
+		default:
+			return nil, fmt.Errorf("cannot tell the format of '%s', use --input-format", filePath)
+		}
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read '%s': %w", filePath, err)
+	}
+
+	var records []map[string]any
+	switch format {
+	case "csv":
+		reader := csv.NewReader(strings.NewReader(string(content)))
+		lines, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' as CSV: %w", filePath, err)
+		}
+		if len(lines) == 0 {
+			return nil, nil
+		}
+
+		header := lines[0]
+		for _, line := range lines[1:] {
+			record := map[string]any{}
+			for i, column := range header {
+				if i < len(line) && line[i] != "" {
+					record[column] = line[i]
+				}
+			}
+			records = append(records, record)
+		}
+	case "yaml":
+		if err := yaml.Unmarshal(content, &records); err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' as a YAML list: %w", filePath, err)
+		}
+	case "jsonl":
+		for i, line := range strings.Split(string(content), "\n") {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+
+			var record map[string]any
+			if err := json.Unmarshal([]byte(line), &record); err != nil {
+				return nil, fmt.Errorf("failed to parse line %d of '%s' as JSON: %w", i+1, filePath, err)
+			}
+			records = append(records, record)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported input format '%s', must be one of: csv, yaml, jsonl", format)
+	}
+
+	return records, nil
+}
+
+// getImportFieldTypes returns the schema type of every custom field by ID, if
+// any row sets a custom field
+func getImportFieldTypes(records []map[string]any) (map[string]string, error) {
+	hasCustomFields := false
+	for _, record := range records {
+		for column := range record {
+			if strings.HasPrefix(normalizeImportColumn(column), "customfield_") {
+				hasCustomFields = true
+			}
+		}
+	}
+	if !hasCustomFields {
+		return nil, nil
+	}
+
+	fields, err := jiraClient.GetFields()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the custom fields: %s", err)
+	}
+
+	fieldTypes := map[string]string{}
+	for _, field := range fields {
+		fieldTypes[field.ID] = field.SchemaType
+	}
+
+	return fieldTypes, nil
+}
+
+// normalizeImportColumn returns the column name in lower case, without spaces
+// around it
+func normalizeImportColumn(column string) string {
+	return strings.ToLower(strings.TrimSpace(column))
+}
+
+func parseImportRow(number int, record map[string]any, fieldTypes map[string]string) (importRow, error) {
+	options := jira.CreateIssueOptions{
+		Project:      importProjectID,
+		IssueType:    importIssueTypeID,
+		CustomFields: map[string]any{},
+	}
+
+	for column, value := range record {
+		switch name := normalizeImportColumn(column); name {
+		case "summary", "title":
+			options.Title = strings.TrimSpace(fmt.Sprint(value))
+		case "description":
+			options.Description = fmt.Sprint(value)
+		case "project", "project_id":
+			options.Project = strings.TrimSpace(fmt.Sprint(value))
+		case "issue_type", "issuetype", "issue_type_id":
+			options.IssueType = strings.TrimSpace(fmt.Sprint(value))
+		case "parent":
+			options.Parent = strings.TrimSpace(fmt.Sprint(value))
+		case "labels":
+			options.Labels = importListValue(value)
+			for _, label := range options.Labels {
+				if strings.ContainsAny(label, " \t") {
+					return importRow{}, fmt.Errorf("label '%s' must not contain spaces", label)
+				}
+			}
+		case "components":
+			options.Components = importListValue(value)
+		default:
+			if !strings.HasPrefix(name, "customfield_") {
+				return importRow{}, fmt.Errorf("unknown column '%s'", column)
+			}
+			fieldType, ok := fieldTypes[name]
+			if !ok {
+				return importRow{}, fmt.Errorf("unknown custom field '%s'", column)
+			}
+			customFieldValue, err := importCustomFieldValue(value, fieldType)
+			if err != nil {
+				return importRow{}, fmt.Errorf("invalid value for '%s': %s", column, err)
+			}
+			options.CustomFields[name] = customFieldValue
+		}
+	}
+
+	if options.Project == "" {
+		options.Project = viper.GetString(string(util.DefaultProjectIDKey))
+	}
+	if options.Labels == nil {
+		options.Labels = util.GetStringList(util.DefaultLabelsKey)
+	}
+	if options.Components == nil {
+		options.Components = util.GetStringList(util.DefaultComponentsKey)
+	}
+	if options.IssueType == "" {
+		options.IssueType = viper.GetString(string(util.DefaultIssueTypeIDKey))
+	}
+
+	if options.Title == "" {
+		return importRow{}, fmt.Errorf("missing summary")
+	}
+	if options.Project == "" {
+		return importRow{}, fmt.Errorf("missing project and no default project is configured")
+	}
+	if options.IssueType == "" {
+		return importRow{}, fmt.Errorf("missing issue type and no default issue type is configured")
+	}
+
+	return importRow{Number: number, Options: options}, nil
+}
+
+// importListValue reads a list column, either a YAML/JSON list or a comma-separated string
+func importListValue(value any) []string {
+	var items []string
+	switch v := value.(type) {
+	case []any:
+		for _, item := range v {
+			items = append(items, strings.TrimSpace(fmt.Sprint(item)))
+		}
+	default:
+		for _, item := range strings.Split(fmt.Sprint(v), ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items
+}
+
+// importCustomFieldValue keeps typed values as-is. CSV cells are converted to
+// numbers for number fields, and decoded if they hold a JSON object or list
+// (e.g. '{"value": "High"}'), and are sent as text otherwise.
+func importCustomFieldValue(value any, fieldType string) (any, error) {
+	str, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	str = strings.TrimSpace(str)
+	if strings.HasPrefix(str, "{") || strings.HasPrefix(str, "[") {
+		var decoded any
+		if err := json.Unmarshal([]byte(str), &decoded); err == nil {
+			return decoded, nil
+		}
+	}
+
+	if fieldType == "number" {
+		number, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a number", str)
+		}
+		return number, nil
+	}
+
+	return value, nil
+}
+
+// checkImportRows looks up each distinct project and parent of the rows once, and
+// resolves the issue types of the rows to their IDs in the project. It returns
+// the problems found, one per row.
+func checkImportRows(rows []importRow) []string {
+	type projectLookup struct {
+		issueTypes []jira.IssueType
+		err        error
+	}
+	projects := map[string]projectLookup{}
+	parents := map[string]error{}
+
+	var rowErrors []string
+	for i, row := range rows {
+		options := &rows[i].Options
+
+		project, ok := projects[options.Project]
+		if !ok {
+			project.issueTypes, project.err = jiraClient.GetProjectIssueTypes(options.Project)
+			projects[options.Project] = project
+		}
+		if project.err != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("  row %d: failed to get issue types of project '%s': %s", row.Number, options.Project, firstLine(project.err.Error())))
+			continue
+		}
+
+		issueType, err := util.MatchIssueType(project.issueTypes, options.IssueType)
+		if err != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("  row %d: failed to find issue type in project '%s': %s", row.Number, options.Project, err))
+			continue
+		}
+		options.IssueType = issueType.ID
+
+		if options.Parent == "" {
+			continue
+		}
+		err, ok = parents[options.Parent]
+		if !ok {
+			_, err = jiraClient.GetIssueByID(options.Parent)
+			parents[options.Parent] = err
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("  row %d: failed to get parent '%s': %s", row.Number, options.Parent, firstLine(err.Error())))
+		}
+	}
+
+	return rowErrors
+}
+
+func createImportRows(rows []importRow) []importResult {
+	results := make([]importResult, len(rows))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, importParallel)
+	for i, row := range rows {
+		wg.Add(1)
+		go func(i int, row importRow) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			result := importResult{Row: row.Number}
+			key, err := jiraClient.CreateIssueWithOptions(row.Options)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Key = key
+			}
+			results[i] = result
+		}(i, row)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func readImportState(stateFile string) (map[int]importResult, error) {
+	results := map[int]importResult{}
+
+	content, err := os.ReadFile(stateFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return results, nil
+		}
+		return nil, fmt.Errorf("failed to read state file '%s': %w", stateFile, err)
+	}
+
+	var state []importResult
+	if err := json.Unmarshal(content, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse state file '%s': %w", stateFile, err)
+	}
+	for _, result := range state {
+		results[result.Row] = result
+	}
+
+	return results, nil
+}
+
+func writeImportState(stateFile string, results map[int]importResult) error {
+	state := make([]importResult, 0, len(results))
+	for _, result := range results {
+		state = append(state, result)
+	}
+	sort.Slice(state, func(i, j int) bool { return state[i].Row < state[j].Row })
+
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to form state file: %w", err)
+	}
+
+	if err := os.WriteFile(stateFile, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write state file '%s': %w", stateFile, err)
+	}
+
+	return nil
+}
+
+// importPlanRow is an issue that would be created, as printed by --dry-run
+type importPlanRow struct {
+	Row       int    `json:"row"`
+	Project   string `json:"project"`
+	IssueType string `json:"issueType"`
+	Parent    string `json:"parent"`
+	Summary   string `json:"summary"`
+}
+
+// importPlanColumns are the columns of the import plan
+var importPlanColumns = []util.Column[importPlanRow]{
+	{Header: "Row", Field: "row", Value: func(row importPlanRow) string { return strconv.Itoa(row.Row) }},
+	{Header: "Project", Field: "project", Value: func(row importPlanRow) string { return row.Project }},
+	{Header: "Issue Type", Field: "issueType", Value: func(row importPlanRow) string { return row.IssueType }},
+	{Header: "Parent", Field: "parent", Value: func(row importPlanRow) string { return row.Parent }},
+	{Header: "Summary", Field: "summary", Value: func(row importPlanRow) string { return row.Summary }},
+}
+
+func printImportPlan(rows []importRow) error {
+	plan := make([]importPlanRow, len(rows))
+	for i, row := range rows {
+		plan[i] = importPlanRow{
+			Row:       row.Number,
+			Project:   row.Options.Project,
+			IssueType: row.Options.IssueType,
+			Parent:    row.Options.Parent,
+			Summary:   row.Options.Title,
+		}
+	}
+
+	if err := util.PrintList(plan, importPlanColumns); err != nil {
+		return err
+	}
+
+	if util.IsTableOutput() {
+		fmt.Printf("\n%d issue(s) would be created.\n", len(rows))
+	}
+	return nil
+}
+
+// importOutcome is an import row and the result of creating its issue, as printed
+type importOutcome struct {
+	Row     int    `json:"row"`
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	Error   string `json:"error,omitempty"`
+}
+
+// importOutcomeColumns are the columns of the import results
+var importOutcomeColumns = []util.Column[importOutcome]{
+	{Header: "Row", Field: "row", Value: func(outcome importOutcome) string { return strconv.Itoa(outcome.Row) }},
+	{Header: "Key", Field: "key", Value: func(outcome importOutcome) string { return outcome.Key }},
+	{Header: "Summary", Field: "summary", Value: func(outcome importOutcome) string { return outcome.Summary }},
+	{Header: "Error", Field: "error", Value: func(outcome importOutcome) string { return firstLine(outcome.Error) }},
+}
+
+func printImportResults(rows []importRow, results map[int]importResult) error {
+	var failures []string
+	outcomes := make([]importOutcome, len(rows))
+	for i, row := range rows {
+		result := results[row.Number]
+		if result.Key == "" {
+			failures = append(failures, fmt.Sprintf("row %d: %s", row.Number, result.Error))
+		}
+		outcomes[i] = importOutcome{Row: row.Number, Key: result.Key, Summary: row.Options.Title, Error: result.Error}
+	}
+
+	if err := util.PrintList(outcomes, importOutcomeColumns); err != nil {
+		return err
+	}
+
+	if len(failures) > 0 {
+		if util.IsTableOutput() {
+			fmt.Printf("\n%s\n", strings.Join(failures, "\n"))
+		}
+		return fmt.Errorf("failed to create %d issue(s), run the command again with --resume to retry them (state saved to '%s')", len(failures), importStateFile)
+	}
+
+	if util.IsTableOutput() {
+		fmt.Printf("\nAll %d issue(s) created.\n", len(rows))
+	}
+	return nil
+}
+
+func firstLine(str string) string {
+	line, _, _ := strings.Cut(str, "\n")
+	return line
+}
@@ -0,0 +1,184 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package issue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var (
+	moveStatus string
+	isMoveYes  bool
+)
+
+func newMoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "move ISSUE_ID --status STATUS",
+		Short: "Move a Jira issue to a status through as many transitions as needed",
+		Long: `Move a Jira issue to a status that may not be reachable with a single transition.
+
+The shortest sequence of transitions is worked out from the project's workflow, shown, then carried out one step at a time. The move stops before any intermediate transition whose screen has required fields. Fields for the last transition can be passed with --resolution, --comment, and --field, and are prompted for otherwise. If the workflow can't be read (e.g. missing permissions), only a single transition to the target status is attempted.`,
+		Args: cobra.ExactArgs(1),
+		Example: `# Move an issue from 'To Do' to 'In Review', going through 'In Progress'
+jira issue move PROJ-123 --status "In Review"
+
+# Move an issue without asking for confirmation
+jira issue move PROJ-123 --status done --yes`,
+		Annotations: map[string]string{
+			util.TableOutputOnlyAnnotation: "",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return moveIssue(args[0], moveStatus)
+		},
+	}
+
+	cmd.Flags().StringVarP(&moveStatus, "status", "s", "", "status to move the issue to")
+	cmd.Flags().BoolVarP(&isMoveYes, "yes", "y", false, "move the issue without asking for confirmation")
+	addTransitionFieldFlags(cmd)
+	cmd.MarkFlagRequired("status")
+
+	return cmd
+}
+
+func moveIssue(issueID string, target string) error {
+	issue, err := jiraClient.GetIssueByID(issueID)
+	if err != nil {
+		return fmt.Errorf("failed to get issue %s: %s", issueID, err)
+	}
+
+	workflow, err := jiraClient.GetWorkflow(issue.ProjectID, issue.IssueTypeID)
+	if err != nil {
+		// without the workflow, the best we can do is a single hop
+		fmt.Printf("Could not read the workflow for %s, trying a single transition instead: %s\n", issueID, firstLine(err.Error()))
+		return transitionIssueByName(issueID, target)
+	}
+
+	targetStatus, err := matchWorkflowStatus(workflow, target)
+	if err != nil {
+		return err
+	}
+	if targetStatus.ID == issue.StatusID {
+		fmt.Printf("Issue %s is already in '%s'.\n", issueID, targetStatus.Name)
+		return nil
+	}
+
+	path, ok := workflow.ShortestPath(issue.StatusID, targetStatus.ID)
+	if !ok {
+		return fmt.Errorf("status '%s' can't be reached from '%s' in workflow '%s'", targetStatus.Name, issue.Status, workflow.Name)
+	}
+
+	// show the planned path
+	fmt.Printf("Planned path for %s:\n", issueID)
+	fromStatus := issue.Status
+	for i, step := range path {
+		toStatus, _ := workflow.StatusByID(step.To)
+		fmt.Printf("  %d. %s -> %s (%s)\n", i+1, fromStatus, toStatus.Name, step.Name)
+		fromStatus = toStatus.Name
+	}
+
+	if !isMoveYes {
+		isConfirmed, err := util.UserYesNo("\nProceed?")
+		if err != nil {
+			return err
+		}
+		if !isConfirmed {
+			return nil
+		}
+	}
+
+	for i, step := range path {
+		isLastStep := i == len(path)-1
+		if err := moveIssueStep(issueID, step, isLastStep); err != nil {
+			return err
+		}
+
+		toStatus, _ := workflow.StatusByID(step.To)
+		fmt.Printf("Issue %s transitioned to '%s'.\n", issueID, toStatus.Name)
+	}
+
+	return nil
+}
+
+// moveIssueStep carries out a planned transition using the transitions that are
+// currently available for the issue
+func moveIssueStep(issueID string, step jira.WorkflowTransition, isLastStep bool) error {
+	transitions, err := jiraClient.GetTransitions(issueID)
+	if err != nil {
+		return fmt.Errorf("failed to get valid transitions for issue: %s", err)
+	}
+
+	var transition jira.Transition
+	for _, available := range transitions {
+		if available.ID == step.ID || (transition.ID == "" && available.ToStatusID == step.To) {
+			transition = available
+		}
+	}
+	if transition.ID == "" {
+		return fmt.Errorf("stopped: transition '%s' is not available for issue %s, it may be restricted by a workflow condition", step.Name, issueID)
+	}
+
+	// screen fields and the comment only apply to the last step
+	options := jira.TransitionIssueOptions{}
+	if !isLastStep {
+		if requiredFields := transition.RequiredFields(); len(requiredFields) > 0 {
+			return fmt.Errorf("stopped: transition '%s' requires '%s' to be filled in, run 'jira issue transition %s' to continue", transition.Name, requiredFields[0].Name, issueID)
+		}
+	} else {
+		options, err = transitionIssueOptions(transition, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := jiraClient.TransitionIssueWithOptions(issueID, transition.ID, options); err != nil {
+		return fmt.Errorf("failed when transitioning issue %s with '%s': %s", issueID, transition.Name, err)
+	}
+
+	return nil
+}
+
+func matchWorkflowStatus(workflow jira.Workflow, target string) (jira.WorkflowStatus, error) {
+	names := make([]string, len(workflow.Statuses))
+	for i, status := range workflow.Statuses {
+		names[i] = status.Name
+	}
+
+	matches := util.FuzzyMatch(target, names)
+	switch len(matches) {
+	case 0:
+		return jira.WorkflowStatus{}, fmt.Errorf("no status matches '%s' in workflow '%s', available statuses: %s", target, workflow.Name, strings.Join(names, ", "))
+	case 1:
+		return workflow.Statuses[matches[0]], nil
+	default:
+		candidates := make([]string, len(matches))
+		for i, match := range matches {
+			candidates[i] = fmt.Sprintf("'%s'", names[match])
+		}
+		return jira.WorkflowStatus{}, fmt.Errorf("'%s' is ambiguous, it matches: %s", target, strings.Join(candidates, ", "))
+	}
+}
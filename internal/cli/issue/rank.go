@@ -0,0 +1,146 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package issue
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	rankBefore   string
+	rankAfter    string
+	isRankTop    bool
+	isRankBottom bool
+	rankBoard    string
+)
+
+func newRankCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rank ISSUE_ID... (--before ISSUE_ID | --after ISSUE_ID | --top | --bottom)",
+		Short: "Rank Jira issues in the backlog",
+		Long: `Move issues up or down the rank order, before or after another issue, or to the top or bottom of a board's backlog.
+
+When several issues are ranked at once, they keep the order in which they are passed in. --top and --bottom use the board passed in with --board, or the default board.`,
+		Args: cobra.MinimumNArgs(1),
+		Example: `# Rank an issue right above another one
+jira issue rank PROJ-1 --before PROJ-2
+
+# Rank two issues, in order, right below another one
+jira issue rank PROJ-4 PROJ-5 --after PROJ-3
+
+# Move an issue to the top of the default board's backlog
+jira issue rank PROJ-6 --top`,
+		Annotations: map[string]string{
+			util.TableOutputOnlyAnnotation: "",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return rankIssues(args)
+		},
+	}
+
+	cmd.Flags().StringVar(&rankBefore, "before", "", "rank the issues right before this issue")
+	cmd.Flags().StringVar(&rankAfter, "after", "", "rank the issues right after this issue")
+	cmd.Flags().BoolVar(&isRankTop, "top", false, "rank the issues at the top of the board's backlog")
+	cmd.Flags().BoolVar(&isRankBottom, "bottom", false, "rank the issues at the bottom of the board's backlog")
+	cmd.Flags().StringVarP(&rankBoard, "board", "b", "", "board whose backlog to use with --top or --bottom, by ID or name (default is the configured default board)")
+	cmd.MarkFlagsMutuallyExclusive("before", "after", "top", "bottom")
+	cmd.MarkFlagsOneRequired("before", "after", "top", "bottom")
+
+	return cmd
+}
+
+func rankIssues(issueIDs []string) error {
+	before, after := rankBefore, rankAfter
+
+	if isRankTop || isRankBottom {
+		anchor, err := backlogAnchor(issueIDs)
+		if err != nil {
+			return err
+		}
+
+		// nothing else is in the backlog, so the issues are already at the top or bottom
+		if anchor == "" {
+			fmt.Println("The backlog has no other issues to rank against.")
+			return nil
+		}
+
+		if isRankTop {
+			before = anchor
+		} else {
+			after = anchor
+		}
+	}
+
+	if slices.Contains(issueIDs, before) || slices.Contains(issueIDs, after) {
+		return fmt.Errorf("an issue can't be ranked relative to itself")
+	}
+
+	if err := jiraClient.RankIssues(issueIDs, before, after); err != nil {
+		return err
+	}
+
+	if before != "" {
+		fmt.Printf("Ranked %d issue(s) before %s.\n", len(issueIDs), before)
+	} else {
+		fmt.Printf("Ranked %d issue(s) after %s.\n", len(issueIDs), after)
+	}
+	return nil
+}
+
+// backlogAnchor returns the first (for --top) or last (for --bottom) issue in the
+// board's backlog that isn't being ranked
+func backlogAnchor(issueIDs []string) (string, error) {
+	boardNameOrID := rankBoard
+	if boardNameOrID == "" {
+		boardNameOrID = viper.GetString(string(util.DefaultBoardIDKey))
+	}
+	if boardNameOrID == "" {
+		return "", fmt.Errorf("missing --board and no default board is configured")
+	}
+
+	board, err := jiraClient.FindBoard(boardNameOrID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get board: %s", err)
+	}
+
+	issues, err := jiraClient.GetBacklogIssues(board.ID, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to get backlog of board '%s': %s", board.Name, err)
+	}
+
+	if isRankBottom {
+		slices.Reverse(issues)
+	}
+	for _, issue := range issues {
+		if !slices.Contains(issueIDs, issue.Key) {
+			return issue.Key, nil
+		}
+	}
+
+	return "", nil
+}
@@ -23,29 +23,134 @@ package issue
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
 
 	"github.com/eeternalsadness/jira/internal/util"
 	"github.com/eeternalsadness/jira/pkg/jira"
 	"github.com/spf13/cobra"
 )
 
+var (
+	transitionJQL      string
+	transitionTo       string
+	transitionParallel int
+	isTransitionYes    bool
+	isTransitionList   bool
+)
+
+// transitionResult is the outcome of transitioning a single issue in bulk
+type transitionResult struct {
+	Issue      jira.Issue
+	Transition jira.Transition
+	Err        error
+}
+
+// transitionOutcome is a transition result as printed
+type transitionOutcome struct {
+	Key   string `json:"key"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Error string `json:"error,omitempty"`
+}
+
+// transitionOutcomeColumns are the columns of the transition results
+var transitionOutcomeColumns = []util.Column[transitionOutcome]{
+	{Header: "Issue", Field: "key", Value: func(outcome transitionOutcome) string { return outcome.Key }},
+	{Header: "From", Field: "from", Value: func(outcome transitionOutcome) string { return outcome.From }},
+	{Header: "To", Field: "to", Value: func(outcome transitionOutcome) string { return outcome.To }},
+	{Header: "Result", Field: "error", Value: func(outcome transitionOutcome) string {
+		if outcome.Error != "" {
+			return fmt.Sprintf("FAILED: %s", firstLine(outcome.Error))
+		}
+		return "OK"
+	}},
+}
+
 func newTransitionCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transition ISSUE_ID",
-		Short: "Transition a Jira issue",
-		Long:  `Transition a Jira issue using the issue ID. The user is prompted to select a transition from a list of valid transitions for the issue.`,
-		Args:  cobra.ExactArgs(1),
+		Use:   "transition [ISSUE_ID...] [--jql JQL] [--to STATUS]",
+		Short: "Transition Jira issues",
+		Long: `Transition Jira issues using their IDs or a JQL query.
+
+With a single issue and no --to flag, the user is prompted to select a transition from a list of valid transitions for the issue.
+
+With --to, the transition is looked up for each issue by its target status or transition name, since transition IDs differ between workflows. Matching is case-insensitive and accepts prefixes and abbreviations (e.g. 'in prog' for 'In Progress'). The command fails if no transition matches or if several transitions match equally well. When transitioning more than one issue, the affected issues are listed for confirmation unless --yes is passed, which is required with --output or --format so that the results can be parsed.
+
+Fields required by a transition's screen (e.g. Resolution) can be passed with --resolution, --comment, and --field. --resolution is ignored for transitions without a resolution field. Missing required fields are prompted for when transitioning a single issue, and make the transition fail when transitioning in bulk.`,
+		Args: cobra.ArbitraryArgs,
+		Example: `# Select a transition for an issue interactively
+jira issue transition PROJ-123
+
+# Transition an issue to 'In Progress' without prompting
+jira issue transition PROJ-123 --to "in prog"
+
+# Transition several issues to 'Done'
+jira issue transition PROJ-123 PROJ-124 --to Done --resolution Fixed --comment "Released in 1.2.0"
+
+# List the valid transitions of an issue as JSON
+jira issue transition PROJ-123 --list --output json
+
+# Transition all issues matching a JQL query without confirmation
+jira issue transition --jql "project = PROJ AND status = 'In Review'" --to Done --yes`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cmd.SilenceUsage = true
+			if len(args) == 0 && transitionJQL == "" {
+				cmd.Usage()
+				return fmt.Errorf("missing issue IDs or --jql")
+			}
 
-			issueID := args[0]
-			return transitionIssue(issueID)
+			cmd.SilenceUsage = true
+			if isTransitionList {
+				if len(args) != 1 || transitionJQL != "" {
+					return fmt.Errorf("--list takes a single issue ID")
+				}
+				return listTransitions(args[0])
+			}
+			if len(args) == 1 && transitionJQL == "" {
+				if transitionTo != "" {
+					return transitionIssueByName(args[0], transitionTo)
+				}
+				return transitionIssue(args[0])
+			}
+			return transitionIssues(args)
 		},
 	}
 
+	cmd.Flags().StringVar(&transitionJQL, "jql", "", "transition all issues matching the JQL query")
+	cmd.Flags().StringVar(&transitionTo, "to", "", "target status or transition name")
+	cmd.Flags().IntVar(&transitionParallel, "parallel", 4, "maximum number of issues transitioned at the same time")
+	cmd.Flags().BoolVarP(&isTransitionYes, "yes", "y", false, "transition the issues without asking for confirmation")
+	cmd.Flags().BoolVar(&isTransitionList, "list", false, "list the valid transitions of the issue instead of transitioning it")
+	addTransitionFieldFlags(cmd)
+	util.AddFormatFlag(cmd)
+
 	return cmd
 }
 
+// transitionColumns are the columns of transition lists
+var transitionColumns = []util.Column[jira.Transition]{
+	{Header: "ID", Field: "id", Value: func(transition jira.Transition) string { return transition.ID }},
+	{Header: "Name", Field: "name", Value: func(transition jira.Transition) string { return transition.Name }},
+	{Header: "To Status", Field: "toStatus", Value: func(transition jira.Transition) string { return transition.ToStatus }},
+	{Header: "Category", Field: "category", Value: func(transition jira.Transition) string { return transition.Category }},
+}
+
+func listTransitions(issueID string) error {
+	transitions, err := jiraClient.GetTransitions(issueID)
+	if err != nil {
+		return fmt.Errorf("failed to get valid transitions for issue: %s", err)
+	}
+
+	if len(transitions) == 0 && util.IsTableOutput() {
+		fmt.Printf("Issue %s has no valid transitions.\n", issueID)
+		return nil
+	}
+
+	return util.PrintList(transitions, transitionColumns)
+}
+
 func transitionIssue(issueID string) error {
 	transitions, err := jiraClient.GetTransitions(issueID)
 	if err != nil {
@@ -58,16 +163,254 @@ func transitionIssue(issueID string) error {
 	}
 
 	// user quits
-	if transition == (jira.Transition{}) {
+	if transition.ID == "" {
 		return nil
 	}
 
-	err = jiraClient.TransitionIssue(issueID, transition.ID)
+	options, err := transitionIssueOptions(transition, true)
+	if err != nil {
+		if err == util.ErrUserQuit {
+			return nil
+		}
+		return err
+	}
+
+	err = jiraClient.TransitionIssueWithOptions(issueID, transition.ID, options)
 	if err != nil {
 		return fmt.Errorf("failed when transitioning issue %s: %s", issueID, err)
 	}
 
-	fmt.Printf("Issue %s transitioned to '%s'.\n", issueID, transition.Name)
+	outcome := transitionOutcome{Key: issueID, To: transition.ToStatus}
+	return util.PrintItem(outcome, transitionOutcomeColumns, func() error {
+		fmt.Printf("Issue %s transitioned to '%s'.\n", issueID, transition.Name)
+		return nil
+	})
+}
+
+func transitionIssueByName(issueID string, target string) error {
+	transition, err := transitionIssueTo(issueID, target, true)
+	if err != nil {
+		return fmt.Errorf("failed when transitioning issue %s: %s", issueID, err)
+	}
+
+	outcome := transitionOutcome{Key: issueID, To: transition.ToStatus}
+	return util.PrintItem(outcome, transitionOutcomeColumns, func() error {
+		fmt.Printf("Issue %s transitioned to '%s'.\n", issueID, transition.ToStatus)
+		return nil
+	})
+}
+
+func transitionIssues(issueIDs []string) error {
+	if transitionTo == "" {
+		return fmt.Errorf("--to is required when transitioning more than one issue")
+	}
+	if transitionParallel < 1 {
+		return fmt.Errorf("--parallel must be at least 1")
+	}
+	// the confirmation prompt would be mixed into the results
+	if !isTransitionYes && !util.IsTableOutput() {
+		return fmt.Errorf("--yes is required to transition more than one issue with --output or --format")
+	}
+
+	issues, lookupFailures, err := getIssuesToTransition(issueIDs)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 && len(lookupFailures) == 0 && util.IsTableOutput() {
+		fmt.Println("No issues to transition.")
+		return nil
+	}
+	if len(issues) == 0 {
+		return printTransitionResults(lookupFailures)
+	}
+
+	// list the affected issues before doing anything
+	if !isTransitionYes {
+		fmt.Printf("The following issues will be transitioned to '%s':\n", transitionTo)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Issue\tStatus\tSummary\t")
+		for _, issue := range issues {
+			fmt.Fprintf(w, "%s\t%s\t%s\t\n", issue.Key, issue.Status, issue.Title)
+		}
+		w.Flush()
+		for _, failure := range lookupFailures {
+			fmt.Printf("Skipping %s: %s\n", failure.Issue.Key, failure.Err)
+		}
+
+		isConfirmed, err := util.UserYesNo(fmt.Sprintf("\nTransition %d issue(s)?", len(issues)))
+		if err != nil {
+			return err
+		}
+		if !isConfirmed {
+			return nil
+		}
+	}
+
+	results := make([]transitionResult, len(issues))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, transitionParallel)
+	for i, issue := range issues {
+		wg.Add(1)
+		go func(i int, issue jira.Issue) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			transition, err := transitionIssueTo(issue.Key, transitionTo, false)
+			results[i] = transitionResult{Issue: issue, Transition: transition, Err: err}
+		}(i, issue)
+	}
+	wg.Wait()
+
+	return printTransitionResults(append(lookupFailures, results...))
+}
+
+// getIssuesToTransition looks up the issues passed in by ID and the ones
+// matching --jql, without duplicates. Issues passed in by ID are looked up one by
+// one, so that an unknown key is returned as a failed result instead of failing
+// the whole search.
+func getIssuesToTransition(issueIDs []string) ([]jira.Issue, []transitionResult, error) {
+	issues := make([]jira.Issue, len(issueIDs))
+	errs := make([]error, len(issueIDs))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, transitionParallel)
+	for i, issueID := range issueIDs {
+		wg.Add(1)
+		go func(i int, issueID string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			issues[i], errs[i] = jiraClient.GetIssueByID(issueID)
+		}(i, issueID)
+	}
+	wg.Wait()
+
+	var found []jira.Issue
+	var lookupFailures []transitionResult
+	for i, issueID := range issueIDs {
+		if errs[i] != nil {
+			lookupFailures = append(lookupFailures, transitionResult{
+				Issue: jira.Issue{Key: issueID},
+				Err:   fmt.Errorf("failed to get issue: %s", errs[i]),
+			})
+			continue
+		}
+		found = append(found, issues[i])
+	}
+
+	if transitionJQL != "" {
+		matches, err := jiraClient.SearchIssues(transitionJQL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to search issues with JQL: %s", err)
+		}
+		found = append(found, matches...)
+	}
+
+	seen := map[string]bool{}
+	uniqueIssues := []jira.Issue{}
+	for _, issue := range found {
+		if !seen[issue.Key] {
+			seen[issue.Key] = true
+			uniqueIssues = append(uniqueIssues, issue)
+		}
+	}
+
+	return uniqueIssues, lookupFailures, nil
+}
+
+// transitionIssueTo transitions an issue using the transition matching target,
+// which is either the target status or the transition name
+func transitionIssueTo(issueID string, target string, isInteractive bool) (jira.Transition, error) {
+	transitions, err := jiraClient.GetTransitions(issueID)
+	if err != nil {
+		return jira.Transition{}, fmt.Errorf("failed to get valid transitions: %s", err)
+	}
+
+	transition, err := matchTransition(transitions, target)
+	if err != nil {
+		return jira.Transition{}, err
+	}
+
+	options, err := transitionIssueOptions(transition, isInteractive)
+	if err != nil {
+		return transition, err
+	}
+
+	if err := jiraClient.TransitionIssueWithOptions(issueID, transition.ID, options); err != nil {
+		return transition, fmt.Errorf("failed to transition: %s", err)
+	}
+
+	return transition, nil
+}
+
+// matchTransition finds the transition whose name or target status best matches
+// target, case-insensitively with prefix and fuzzy matching. It fails if several
+// transitions match equally well.
+func matchTransition(transitions []jira.Transition, target string) (jira.Transition, error) {
+	// match the transition names followed by their target statuses
+	candidates := make([]string, 0, 2*len(transitions))
+	for _, transition := range transitions {
+		candidates = append(candidates, transition.Name)
+	}
+	for _, transition := range transitions {
+		candidates = append(candidates, transition.ToStatus)
+	}
+
+	var matches []jira.Transition
+	matched := map[int]bool{}
+	for _, match := range util.FuzzyMatch(target, candidates) {
+		i := match % len(transitions)
+		if !matched[i] {
+			matched[i] = true
+			matches = append(matches, transitions[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		available := make([]string, len(transitions))
+		for i, transition := range transitions {
+			available[i] = describeTransition(transition)
+		}
+		return jira.Transition{}, fmt.Errorf("no transition matches '%s', available transitions: %s", target, strings.Join(available, ", "))
+	case 1:
+		return matches[0], nil
+	default:
+		candidates := make([]string, len(matches))
+		for i, transition := range matches {
+			candidates[i] = describeTransition(transition)
+		}
+		return jira.Transition{}, fmt.Errorf("'%s' is ambiguous, it matches: %s", target, strings.Join(candidates, ", "))
+	}
+}
+
+func describeTransition(transition jira.Transition) string {
+	if strings.EqualFold(transition.Name, transition.ToStatus) {
+		return fmt.Sprintf("'%s'", transition.Name)
+	}
+	return fmt.Sprintf("'%s' (to '%s')", transition.Name, transition.ToStatus)
+}
+
+func printTransitionResults(results []transitionResult) error {
+	failed := 0
+	outcomes := make([]transitionOutcome, len(results))
+	for i, result := range results {
+		outcomes[i] = transitionOutcome{Key: result.Issue.Key, From: result.Issue.Status, To: result.Transition.ToStatus}
+		if result.Err != nil {
+			failed++
+			outcomes[i].Error = result.Err.Error()
+		}
+	}
+
+	if err := util.PrintList(outcomes, transitionOutcomeColumns); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to transition %d of %d issue(s)", failed, len(results))
+	}
+
 	return nil
 }
 
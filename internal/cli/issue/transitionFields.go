@@ -0,0 +1,75 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package issue
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var (
+	transitionResolution  string
+	transitionComment     string
+	transitionFieldValues []string
+)
+
+// addTransitionFieldFlags adds the flags used to fill in transition screens
+func addTransitionFieldFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&transitionResolution, "resolution", "", "resolution to set when the transition asks for one (e.g. Fixed)")
+	cmd.Flags().StringVar(&transitionComment, "comment", "", "comment to add with the transition")
+	cmd.Flags().StringArrayVar(&transitionFieldValues, "field", nil, "set a transition screen field by ID or name, e.g. --field \"Fix versions=1.2.0\" (can be repeated)")
+}
+
+// transitionIssueOptions fills in the transition's screen fields from the flags.
+// Required fields that weren't passed in are prompted for if isInteractive is set,
+// and cause an error otherwise.
+func transitionIssueOptions(transition jira.Transition, isInteractive bool) (jira.TransitionIssueOptions, error) {
+	options := jira.TransitionIssueOptions{
+		Comment: transitionComment,
+	}
+
+	values, err := parseKeyValues(transitionFieldValues, "--field")
+	if err != nil {
+		return options, err
+	}
+	// --resolution only applies to transitions that ask for one, since most
+	// transitions to Done have no screen
+	if _, ok := transition.FieldByName("resolution"); ok && transitionResolution != "" {
+		values["resolution"] = transitionResolution
+	}
+
+	fields, err := util.TransitionFields(transition, values, isInteractive)
+	if err != nil {
+		var missingFieldsErr *util.MissingFieldsError
+		if errors.As(err, &missingFieldsErr) {
+			return options, fmt.Errorf("%s, pass them with --resolution or --field", err)
+		}
+		return options, err
+	}
+	options.Fields = fields
+
+	return options, nil
+}
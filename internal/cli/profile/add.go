@@ -0,0 +1,176 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addDomain       string
+	addEmail        string
+	addToken        string
+	addTokenEnv     string
+	addTokenFile    string
+	addTokenCommand string
+	addUse          bool
+)
+
+func newAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add NAME [--domain DOMAIN] [--email EMAIL] [--token TOKEN | --token-env VAR | --token-file FILE | --token-command COMMAND] [--use]",
+		Short: "Add a profile",
+		Long: `Add a profile with its own Jira credentials. The credentials that aren't passed in are prompted for. A prompted API token isn't echoed, and can be encrypted with a passphrase like with 'jira configure'. To keep the token out of the config file, read it from an environment variable, a file, or a command with --token-env, --token-file, or --token-command.
+
+If the config file has no profiles yet, its existing settings are moved into the 'default' profile. Configure the new profile's default project and issue type with 'jira --profile NAME configure'.`,
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			util.ConfigOptionalAnnotation:  "",
+			util.TableOutputOnlyAnnotation: "",
+		},
+		Example: `# Add a profile, prompting for the credentials
+jira profile add client
+
+# Add a profile and switch to it
+jira profile add client --domain client.atlassian.net --email me@example.com --use
+
+# Add a profile that reads the API token from a password manager
+jira profile add client --token-command "pass show jira/client"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return addProfile(args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&addDomain, "domain", "", "Jira domain of the profile (e.g. example.atlassian.net)")
+	cmd.Flags().StringVar(&addEmail, "email", "", "email address used for Jira")
+	cmd.Flags().StringVar(&addToken, "token", "", "Jira API token")
+	cmd.Flags().StringVar(&addTokenEnv, "token-env", "", "environment variable that holds the Jira API token")
+	cmd.Flags().StringVar(&addTokenFile, "token-file", "", "file that holds the Jira API token")
+	cmd.Flags().StringVar(&addTokenCommand, "token-command", "", "command that prints the Jira API token")
+	cmd.MarkFlagsMutuallyExclusive("token", "token-env", "token-file", "token-command")
+	cmd.Flags().BoolVar(&addUse, "use", false, "switch to the profile after adding it")
+
+	return cmd
+}
+
+func addProfile(name string) error {
+	if strings.TrimSpace(name) == "" || strings.ContainsAny(name, ". ") {
+		return fmt.Errorf("invalid profile name '%s', it can't be empty or contain dots or spaces", name)
+	}
+
+	configFile, err := util.ReadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	if profiles, ok := configFile[string(util.ProfilesKey)].(map[string]any); ok {
+		if _, ok := profiles[name]; ok {
+			return fmt.Errorf("profile '%s' already exists", name)
+		}
+	} else if name == util.DefaultProfile && configFile.HasSettings() {
+		return fmt.Errorf("profile '%s' already exists", name)
+	}
+
+	// prompt for the credentials that weren't passed in
+	credentials := []struct {
+		value  *string
+		prompt string
+	}{
+		{&addDomain, "Enter the Jira domain (e.g. example.atlassian.net): "},
+		{&addEmail, "Enter the email address used for Jira: "},
+	}
+	for _, credential := range credentials {
+		if *credential.value != "" {
+			continue
+		}
+
+		value, err := util.UserGetString(credential.prompt, nil, false)
+		if err != nil {
+			return fmt.Errorf("failed to read user input: %s", err)
+		}
+		if strings.TrimSpace(*value) == "" {
+			return fmt.Errorf("the value can't be empty")
+		}
+		*credential.value = strings.TrimSpace(*value)
+	}
+
+	// the token is only prompted for when it isn't read from elsewhere
+	tokenHelpers := map[util.ViperKey]string{
+		util.JiraTokenEnvKey:     addTokenEnv,
+		util.JiraTokenFileKey:    addTokenFile,
+		util.JiraTokenCommandKey: addTokenCommand,
+	}
+	hasTokenHelper := addTokenEnv != "" || addTokenFile != "" || addTokenCommand != ""
+	if !hasTokenHelper && addToken == "" {
+		token, err := util.UserGetSecret("Enter the Jira API token: ")
+		if err != nil {
+			return fmt.Errorf("failed to read user input: %s", err)
+		}
+		if token == "" {
+			return fmt.Errorf("the Jira API token can't be empty")
+		}
+
+		// optionally encrypt the token at rest
+		addToken, err = util.PromptTokenEncryption(token)
+		if err != nil {
+			return err
+		}
+	}
+
+	// a config file without profiles only gets converted when adding another profile
+	var profile map[string]any
+	if name == util.DefaultProfile && !configFile.HasProfiles() {
+		profile = configFile
+	} else {
+		profile = configFile.Profile(name)
+	}
+	profile[string(util.JiraDomainKey)] = addDomain
+	profile[string(util.JiraEmailKey)] = addEmail
+	if hasTokenHelper {
+		for key, value := range tokenHelpers {
+			if value != "" {
+				profile[string(key)] = value
+			}
+		}
+	} else {
+		profile[string(util.JiraTokenKey)] = addToken
+	}
+	if addUse && configFile.HasProfiles() {
+		configFile[string(util.CurrentProfileKey)] = name
+	}
+
+	if err := configFile.Write(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Profile '%s' added.\n", name)
+	if configFile.HasProfiles() && configFile[string(util.CurrentProfileKey)] != name {
+		fmt.Printf("Run 'jira profile use %s' to switch to it.\n", name)
+	}
+
+	return nil
+}
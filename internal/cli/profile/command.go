@@ -0,0 +1,53 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package profile
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates and returns the profile command
+func NewCommand() *cobra.Command {
+	profileCmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage profiles for different Jira sites",
+		Long: `Manage named profiles, each with its own Jira credentials and defaults, to work with several Jira sites.
+
+The profile in use is, in order of precedence: the one passed in with --profile, the JIRA_PROFILE environment variable, then the current profile set with 'jira profile use'. A config file without profiles holds the settings of the 'default' profile.`,
+		Example: `# Add a profile for another Jira site
+jira profile add client --domain client.atlassian.net --email me@example.com
+
+# Switch to the profile
+jira profile use client
+
+# Run a single command with another profile
+jira --profile default issue get`,
+	}
+
+	// Add subcommands
+	profileCmd.AddCommand(newListCommand())
+	profileCmd.AddCommand(newUseCommand())
+	profileCmd.AddCommand(newAddCommand())
+	profileCmd.AddCommand(newRemoveCommand())
+
+	return profileCmd
+}
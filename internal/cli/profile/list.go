@@ -0,0 +1,124 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package profile
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+)
+
+func newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the profiles",
+		Long:  `List the profiles in the config file with their Jira domain and email. The profile in use is marked with '*'.`,
+		Args:  cobra.NoArgs,
+		Annotations: map[string]string{
+			util.ConfigOptionalAnnotation: "",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return listProfiles()
+		},
+	}
+
+	util.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func listProfiles() error {
+	configFile, err := util.ReadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	profiles := map[string]map[string]any{}
+	if configFile.HasProfiles() {
+		for name, profile := range configFile[string(util.ProfilesKey)].(map[string]any) {
+			profileMap, _ := profile.(map[string]any)
+			profiles[name] = profileMap
+		}
+	} else if configFile.HasSettings() {
+		profiles[util.DefaultProfile] = configFile
+	}
+
+	if len(profiles) == 0 && util.IsTableOutput() {
+		fmt.Println("No profiles found. Run 'jira configure' or 'jira profile add' to create one.")
+		return nil
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rows := make([]profileRow, len(names))
+	for i, name := range names {
+		rows[i] = profileRow{
+			Name:    name,
+			Current: name == util.ActiveProfile(),
+			Domain:  fmt.Sprint(valueOrEmpty(profiles[name], util.JiraDomainKey)),
+			Email:   fmt.Sprint(valueOrEmpty(profiles[name], util.JiraEmailKey)),
+		}
+	}
+
+	return util.PrintList(rows, profileColumns)
+}
+
+// profileRow is a profile in the profile list
+type profileRow struct {
+	Name    string `json:"name"`
+	Current bool   `json:"current"`
+	Domain  string `json:"domain"`
+	Email   string `json:"email"`
+}
+
+// profileColumns are the columns of the profile list, with the profile in use
+// marked with '*' in table output
+var profileColumns = []util.Column[profileRow]{
+	{Header: "Name", Field: "name", Value: func(row profileRow) string { return row.Name }},
+	{Header: "Current", Field: "current", Value: func(row profileRow) string {
+		if !util.IsTableOutput() {
+			return strconv.FormatBool(row.Current)
+		}
+		if row.Current {
+			return "*"
+		}
+		return ""
+	}},
+	{Header: "Domain", Field: "domain", Value: func(row profileRow) string { return row.Domain }},
+	{Header: "Email", Field: "email", Value: func(row profileRow) string { return row.Email }},
+}
+
+func valueOrEmpty(profile map[string]any, key util.ViperKey) any {
+	if value, ok := profile[string(key)]; ok && value != nil {
+		return value
+	}
+
+	return ""
+}
@@ -0,0 +1,91 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package profile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+)
+
+func newRemoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove NAME",
+		Short: "Remove a profile",
+		Long:  `Remove a profile and its settings from the config file. The current profile can't be removed, switch to another profile first.`,
+		Args:  cobra.ExactArgs(1),
+		Example: `# Remove the 'client' profile
+jira profile remove client`,
+		Annotations: map[string]string{
+			util.ConfigOptionalAnnotation:  "",
+			util.TableOutputOnlyAnnotation: "",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return removeProfile(args[0])
+		},
+	}
+
+	return cmd
+}
+
+func removeProfile(name string) error {
+	configFile, err := util.ReadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	if !configFile.HasProfiles() {
+		return fmt.Errorf("the config file has no profiles to remove")
+	}
+
+	profiles := configFile[string(util.ProfilesKey)].(map[string]any)
+	if _, ok := profiles[name]; !ok {
+		return fmt.Errorf("profile '%s' not found, available profiles: %s", name, availableProfiles(configFile))
+	}
+	if configFile[string(util.CurrentProfileKey)] == name {
+		return fmt.Errorf("profile '%s' is the current profile, switch to another profile with 'jira profile use' first", name)
+	}
+
+	delete(profiles, name)
+	if err := configFile.Write(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Profile '%s' removed.\n", name)
+	return nil
+}
+
+func availableProfiles(configFile util.ConfigFile) string {
+	profiles, _ := configFile[string(util.ProfilesKey)].(map[string]any)
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
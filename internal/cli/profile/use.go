@@ -0,0 +1,83 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package profile
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+)
+
+func newUseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use NAME",
+		Short: "Switch to another profile",
+		Long:  `Make a profile the current profile, used by every command unless --profile or JIRA_PROFILE is set.`,
+		Args:  cobra.ExactArgs(1),
+		Example: `# Switch to the 'client' profile
+jira profile use client`,
+		Annotations: map[string]string{
+			util.ConfigOptionalAnnotation:  "",
+			util.TableOutputOnlyAnnotation: "",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return useProfile(args[0])
+		},
+	}
+
+	return cmd
+}
+
+func useProfile(name string) error {
+	configFile, err := util.ReadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	if !configFile.HasProfiles() {
+		if name != util.DefaultProfile {
+			return fmt.Errorf("profile '%s' not found, the config file has no profiles", name)
+		}
+		fmt.Printf("Already using profile '%s'.\n", name)
+		return nil
+	}
+
+	profiles := configFile[string(util.ProfilesKey)].(map[string]any)
+	if _, ok := profiles[name]; !ok {
+		return fmt.Errorf("profile '%s' not found, available profiles: %s", name, availableProfiles(configFile))
+	}
+
+	configFile[string(util.CurrentProfileKey)] = name
+	if err := configFile.Write(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Switched to profile '%s'.\n", name)
+	if envProfile := os.Getenv(util.ProfileEnvVar); envProfile != "" && envProfile != name {
+		fmt.Printf("Note: %s is set to '%s', which takes precedence.\n", util.ProfileEnvVar, envProfile)
+	}
+
+	return nil
+}
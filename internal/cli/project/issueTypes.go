@@ -0,0 +1,81 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package project
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+func newIssueTypesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-types PROJECT",
+		Short: "List the issue types of a Jira project",
+		Long: `List the issue types that issues can be created with in a project, by its key or ID, with their ID, hierarchy level, and whether they are sub-tasks.
+
+Hierarchy levels are 1 (or higher) for epics, 0 for standard issue types, and -1 for sub-tasks. The IDs can be used as 'default_issue_type_id' in the config file, although most commands also accept issue type names.`,
+		Args: cobra.ExactArgs(1),
+		Example: `# List the issue types of a project
+jira project issue-types PROJ`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return listIssueTypes(args[0])
+		},
+	}
+
+	util.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func listIssueTypes(projectIDOrKey string) error {
+	issueTypes, err := jiraClient.GetProjectIssueTypes(projectIDOrKey)
+	if err != nil {
+		return fmt.Errorf("failed to get issue types of project '%s': %s", projectIDOrKey, err)
+	}
+
+	if len(issueTypes) == 0 && util.IsTableOutput() {
+		fmt.Println("No issue types found.")
+		return nil
+	}
+
+	// highest hierarchy level first, e.g. epics before stories before sub-tasks
+	sort.SliceStable(issueTypes, func(i, j int) bool {
+		return issueTypes[i].HierarchyLevel > issueTypes[j].HierarchyLevel
+	})
+
+	return util.PrintList(issueTypes, issueTypeColumns)
+}
+
+// issueTypeColumns are the columns of issue type lists
+var issueTypeColumns = []util.Column[jira.IssueType]{
+	{Header: "ID", Field: "id", Value: func(issueType jira.IssueType) string { return issueType.ID }},
+	{Header: "Name", Field: "name", Value: func(issueType jira.IssueType) string { return issueType.Name }},
+	{Header: "Level", Field: "hierarchyLevel", Value: func(issueType jira.IssueType) string { return strconv.Itoa(issueType.HierarchyLevel) }},
+	{Header: "Sub-task", Field: "subtask", Value: func(issueType jira.IssueType) string { return strconv.FormatBool(issueType.Subtask) }},
+	{Header: "Description", Field: "description", Value: func(issueType jira.IssueType) string { return issueType.Description }},
+}
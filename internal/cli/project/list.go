@@ -0,0 +1,83 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package project
+
+import (
+	"fmt"
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var listQuery string
+
+func newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [--query QUERY]",
+		Short: "List Jira projects",
+		Long:  `List the Jira projects you can see, optionally only the ones whose key or name contains the query (case-insensitive).`,
+		Args:  cobra.NoArgs,
+		Example: `# List all projects
+jira project list
+
+# Search projects by key or name
+jira project list --query platform`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return listProjects()
+		},
+	}
+
+	cmd.Flags().StringVarP(&listQuery, "query", "q", "", "only list projects whose key or name contains the query")
+	util.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func listProjects() error {
+	var projects []jira.Project
+	var err error
+	if listQuery != "" {
+		projects, err = jiraClient.SearchProjects(listQuery)
+	} else {
+		projects, err = jiraClient.ListProjects()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %s", err)
+	}
+
+	if len(projects) == 0 && util.IsTableOutput() {
+		fmt.Println("No projects found.")
+		return nil
+	}
+
+	return util.PrintList(projects, projectColumns)
+}
+
+// projectColumns are the columns of project lists
+var projectColumns = []util.Column[jira.Project]{
+	{Header: "ID", Field: "id", Value: func(project jira.Project) string { return project.ID }},
+	{Header: "Key", Field: "key", Value: func(project jira.Project) string { return project.Key }},
+	{Header: "Name", Field: "name", Value: func(project jira.Project) string { return project.Name }},
+	{Header: "Type", Field: "projectType", Value: func(project jira.Project) string { return project.ProjectType }},
+	{Header: "Lead", Field: "lead", Value: func(project jira.Project) string { return project.Lead }},
+}
@@ -0,0 +1,115 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package project
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+func newShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show PROJECT",
+		Short: "Show the details of a Jira project",
+		Long:  `Show a project by its key or ID, with its lead, issue types, components, and versions.`,
+		Args:  cobra.ExactArgs(1),
+		Example: `# Show a project by key
+jira project show PROJ
+
+# Show a project by ID
+jira project show 10000`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return showProject(args[0])
+		},
+	}
+
+	util.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func showProject(projectIDOrKey string) error {
+	project, err := jiraClient.GetProject(projectIDOrKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project '%s': %s", projectIDOrKey, err)
+	}
+
+	return util.PrintItem(project, projectColumns, func() error {
+		printProject(project)
+		return nil
+	})
+}
+
+func printProject(project jira.Project) {
+	fmt.Printf("Project: %s (%s, ID %s)\n", project.Name, project.Key, project.ID)
+	if project.ProjectType != "" {
+		fmt.Printf("Type: %s\n", project.ProjectType)
+	}
+	if project.Lead != "" {
+		fmt.Printf("Lead: %s\n", project.Lead)
+	}
+	if description := strings.TrimSpace(project.Description); description != "" {
+		fmt.Printf("Description: %s\n", description)
+	}
+	fmt.Printf("URL: https://%s/browse/%s\n", jiraClient.Domain, project.Key)
+
+	fmt.Println("\nIssue types:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, issueType := range project.IssueTypes {
+		fmt.Fprintf(w, "  %s\t%s\t\n", issueType.ID, issueType.Name)
+	}
+	w.Flush()
+
+	fmt.Println("\nComponents:")
+	if len(project.Components) == 0 {
+		fmt.Println("  none")
+	}
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, component := range project.Components {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t\n", component.ID, component.Name, component.Lead, component.Description)
+	}
+	w.Flush()
+
+	fmt.Println("\nVersions:")
+	if len(project.Versions) == 0 {
+		fmt.Println("  none")
+	}
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, version := range project.Versions {
+		state := "unreleased"
+		switch {
+		case version.Archived:
+			state = "archived"
+		case version.Released:
+			state = "released"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t\n", version.ID, version.Name, state, version.ReleaseDate)
+	}
+	w.Flush()
+}
@@ -0,0 +1,74 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package sprint
+
+import (
+	"fmt"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+)
+
+func newAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add SPRINT|current ISSUE_ID...",
+		Short: "Move issues to a sprint",
+		Long: `Move issues to a sprint, from the backlog or from another sprint.
+
+SPRINT is a sprint ID, or a sprint name on the board. 'current' is the active sprint of the board passed in with --board, or of the default board.`,
+		Args: cobra.MinimumNArgs(2),
+		Example: `# Move issues to a sprint by ID
+jira sprint add 123 PROJ-1 PROJ-2
+
+# Move an issue to the active sprint of the default board
+jira sprint add current PROJ-3`,
+		Annotations: map[string]string{
+			util.TableOutputOnlyAnnotation: "",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return addIssuesToSprint(args[0], args[1:])
+		},
+	}
+
+	cmd.Flags().StringVarP(&boardName, "board", "b", "", "board of the sprint, by ID or name (default is the configured default board)")
+
+	return cmd
+}
+
+func addIssuesToSprint(sprintNameOrID string, issueIDs []string) error {
+	sprint, err := resolveSprint(sprintNameOrID)
+	if err != nil {
+		return err
+	}
+
+	if sprint.State == "closed" {
+		return fmt.Errorf("sprint '%s' is closed, issues can only be moved to active or future sprints", sprint.Name)
+	}
+
+	if err := jiraClient.MoveIssuesToSprint(sprint.ID, issueIDs); err != nil {
+		return fmt.Errorf("failed to move issues to sprint '%s': %s", sprint.Name, err)
+	}
+
+	fmt.Printf("Moved %d issue(s) to sprint '%s'.\n", len(issueIDs), sprint.Name)
+	return nil
+}
@@ -0,0 +1,286 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package sprint
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/spf13/cobra"
+)
+
+// burndownDay is the state of a sprint at the end of a day. Scope and Remaining
+// are nil for days that haven't happened yet.
+type burndownDay struct {
+	Date      string   `json:"date"`
+	Scope     *float64 `json:"scope"`
+	Remaining *float64 `json:"remaining"`
+	Ideal     float64  `json:"ideal"`
+}
+
+type burndownScopeChange struct {
+	Time   time.Time `json:"time"`
+	Issue  string    `json:"issue"`
+	Change string    `json:"change"`
+	Value  float64   `json:"value"`
+}
+
+type burndown struct {
+	SprintID     int                   `json:"sprintId"`
+	Sprint       string                `json:"sprint"`
+	Unit         string                `json:"unit"`
+	Committed    float64               `json:"committed"`
+	Days         []burndownDay         `json:"days"`
+	ScopeChanges []burndownScopeChange `json:"scopeChanges"`
+}
+
+func newBurndownCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burndown [SPRINT|current] [--board BOARD]",
+		Short: "Show the burndown chart of a sprint",
+		Long: `Show the burndown chart of a sprint, reconstructed from the changelogs of the sprint's issues.
+
+For each day of the sprint, the chart shows the remaining story points (or issue count when no story points field is configured or set on the board) against an ideal line from the committed scope down to zero. Issues added to or removed from the sprint after it started, and estimates changed during the sprint, are listed as scope changes.
+
+SPRINT is a sprint ID, or a sprint name on the board. 'current' (the default) is the active sprint of the board passed in with --board, or of the default board.
+
+With --output csv or tsv, one row is printed per day. JSON and YAML output also include the scope changes.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `# Show the burndown of the active sprint of the default board
+jira sprint burndown
+
+# Export the burndown of a sprint as CSV
+jira sprint burndown 123 --output csv > burndown.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			sprintNameOrID := "current"
+			if len(args) > 0 {
+				sprintNameOrID = args[0]
+			}
+			return showBurndown(sprintNameOrID)
+		},
+	}
+
+	cmd.Flags().StringVarP(&boardName, "board", "b", "", "board of the sprint, by ID or name (default is the configured default board)")
+
+	return cmd
+}
+
+func showBurndown(sprintNameOrID string) error {
+	sprint, err := resolveSprint(sprintNameOrID)
+	if err != nil {
+		return err
+	}
+	if sprint.StartDate.IsZero() {
+		return fmt.Errorf("sprint '%s' hasn't started yet", sprint.Name)
+	}
+
+	field := util.StoryPointsField(&jiraClient, sprint.BoardID)
+	history, err := getSprintHistory(sprint, field)
+	if err != nil {
+		return err
+	}
+
+	chart := computeBurndown(history)
+	return util.PrintDataWithList(chart, chart.Days, burndownDayColumns, func() error {
+		printBurndownChart(chart)
+		return nil
+	})
+}
+
+// burndownDayColumns are the columns of the burndown's days in CSV and TSV output
+var burndownDayColumns = []util.Column[burndownDay]{
+	{Header: "Date", Field: "date", Value: func(day burndownDay) string { return day.Date }},
+	{Header: "Scope", Field: "scope", Value: func(day burndownDay) string { return formatOptionalPoints(day.Scope) }},
+	{Header: "Remaining", Field: "remaining", Value: func(day burndownDay) string { return formatOptionalPoints(day.Remaining) }},
+	{Header: "Ideal", Field: "ideal", Value: func(day burndownDay) string { return formatPoints(math.Round(day.Ideal*100) / 100) }},
+}
+
+// formatOptionalPoints formats points that are nil for days that haven't happened yet
+func formatOptionalPoints(points *float64) string {
+	if points == nil {
+		return ""
+	}
+
+	return formatPoints(*points)
+}
+
+func computeBurndown(history sprintHistory) burndown {
+	sprint := history.Sprint
+	chart := burndown{
+		SprintID: sprint.ID,
+		Sprint:   sprint.Name,
+		Unit:     "points",
+	}
+	if history.StoryPointsField == "" {
+		chart.Unit = "issues"
+	}
+
+	chart.Committed, _ = history.totalsAt(sprint.StartDate)
+
+	// one sample at the end of each day, from the start to the end of the sprint
+	end := sprint.EndDate
+	if !sprint.CompleteDate.IsZero() {
+		end = sprint.CompleteDate
+	}
+	cutoff := history.endTime()
+
+	start := sprint.StartDate.Local()
+	firstDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+	var days []time.Time
+	for day := firstDay; !day.After(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	if len(days) == 0 {
+		days = append(days, firstDay)
+	}
+
+	for i, day := range days {
+		sampleTime := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if sampleTime.After(end) {
+			sampleTime = end
+		}
+
+		outDay := burndownDay{
+			Date:  day.Format("2006-01-02"),
+			Ideal: chart.Committed,
+		}
+		if len(days) > 1 {
+			outDay.Ideal = chart.Committed * float64(len(days)-1-i) / float64(len(days)-1)
+		}
+
+		if !day.After(cutoff) {
+			if sampleTime.After(cutoff) {
+				sampleTime = cutoff
+			}
+			scope, done := history.totalsAt(sampleTime)
+			remaining := scope - done
+			outDay.Scope = &scope
+			outDay.Remaining = &remaining
+		}
+
+		chart.Days = append(chart.Days, outDay)
+	}
+
+	for _, change := range history.scopeChanges(cutoff) {
+		chart.ScopeChanges = append(chart.ScopeChanges, burndownScopeChange{
+			Time:   change.Time,
+			Issue:  change.Key,
+			Change: change.Change,
+			Value:  change.Value,
+		})
+	}
+
+	return chart
+}
+
+func printBurndownChart(chart burndown) {
+	const height = 12
+	const columnWidth = 3
+
+	// scale the chart to the highest value
+	maxValue := chart.Committed
+	for _, day := range chart.Days {
+		if day.Scope != nil && *day.Scope > maxValue {
+			maxValue = *day.Scope
+		}
+	}
+	if maxValue == 0 {
+		maxValue = 1
+	}
+	row := func(value float64) int {
+		return int(math.Round(value / maxValue * height))
+	}
+
+	// draw the ideal line first so that actual values are drawn over it
+	grid := make([][]rune, height+1)
+	for i := range grid {
+		grid[i] = []rune(strings.Repeat(" ", len(chart.Days)*columnWidth))
+	}
+	for i, day := range chart.Days {
+		x := i*columnWidth + 1
+		grid[row(day.Ideal)][x] = '.'
+		if day.Scope == nil {
+			continue
+		}
+		if *day.Scope != *day.Remaining {
+			grid[row(*day.Scope)][x] = '-'
+		}
+		grid[row(*day.Remaining)][x] = '*'
+	}
+
+	fmt.Printf("Burndown: %s (ID %d), %s\n\n", chart.Sprint, chart.SprintID, chart.Unit)
+
+	labelWidth := len(formatPoints(math.Round(maxValue)))
+	for y := height; y >= 0; y-- {
+		label := ""
+		if y == height || y == 0 || y == height/2 {
+			label = formatPoints(math.Round(maxValue * float64(y) / height))
+		}
+		fmt.Printf("%*s |%s\n", labelWidth, label, strings.TrimRight(string(grid[y]), " "))
+	}
+	fmt.Printf("%*s +%s\n", labelWidth, "", strings.Repeat("-", len(chart.Days)*columnWidth))
+
+	// label the first and last days on the x axis
+	if len(chart.Days) > 0 {
+		first := chart.Days[0].Date[5:]
+		last := chart.Days[len(chart.Days)-1].Date[5:]
+		gap := len(chart.Days)*columnWidth - len(first) - len(last)
+		if gap < 1 {
+			gap = 1
+		}
+		fmt.Printf("%*s  %s%s%s\n", labelWidth, "", first, strings.Repeat(" ", gap), last)
+	}
+	fmt.Printf("\n  * remaining   - scope   . ideal\n")
+
+	// summary of the latest day with data
+	for i := len(chart.Days) - 1; i >= 0; i-- {
+		if chart.Days[i].Scope == nil {
+			continue
+		}
+		scope, remaining := *chart.Days[i].Scope, *chart.Days[i].Remaining
+		fmt.Printf("\nCommitted: %s, scope: %s, done: %s, remaining: %s\n",
+			formatPoints(chart.Committed), formatPoints(scope), formatPoints(scope-remaining), formatPoints(remaining))
+		break
+	}
+
+	if len(chart.ScopeChanges) == 0 {
+		return
+	}
+
+	fmt.Println("\nScope changes:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, change := range chart.ScopeChanges {
+		value := formatPoints(change.Value)
+		if change.Value > 0 {
+			value = "+" + value
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t\n", change.Time.Local().Format("2006-01-02 15:04"), change.Issue, change.Change, value)
+	}
+	w.Flush()
+}
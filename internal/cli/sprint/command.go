@@ -0,0 +1,75 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package sprint
+
+import (
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var jiraClient jira.Jira
+
+// NewCommand creates and returns the sprint command
+func NewCommand() *cobra.Command {
+	sprintCmd := &cobra.Command{
+		Use:   "sprint",
+		Short: "Manage Jira Software sprints",
+		Long:  `List sprints, show the issues in a sprint, move issues between sprints, and chart a sprint's burndown or a team's velocity.`,
+		Example: `# List the active sprints of a board
+jira sprint list 42 --state active
+
+# Show the current sprint of the default board
+jira sprint show current
+
+# Add issues to a sprint
+jira sprint add 123 PROJ-1 PROJ-2
+
+# Show the burndown chart of the current sprint
+jira sprint burndown
+
+# Show the velocity of a board over the last 6 sprints
+jira sprint velocity 42 --last 6`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := util.RunRootPreRun(cmd, args); err != nil {
+				return err
+			}
+
+			var err error
+			jiraClient, err = util.InitJiraConfig()
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	// Add subcommands
+	sprintCmd.AddCommand(newListCommand())
+	sprintCmd.AddCommand(newShowCommand())
+	sprintCmd.AddCommand(newAddCommand())
+	sprintCmd.AddCommand(newBurndownCommand())
+	sprintCmd.AddCommand(newVelocityCommand())
+
+	return sprintCmd
+}
@@ -0,0 +1,328 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package sprint
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eeternalsadness/jira/pkg/jira"
+)
+
+// fieldChange is a change to one field of an issue, taken from its changelog
+type fieldChange struct {
+	Time time.Time
+	From string
+	To   string
+}
+
+// issueHistory replays an issue's changelog backwards from its current state to
+// find the value of its sprints, status, and story points at any point in time
+type issueHistory struct {
+	Issue         jira.Issue
+	Sprints       string
+	SprintChanges []fieldChange
+	StatusChanges []fieldChange
+	PointsChanges []fieldChange
+}
+
+func newIssueHistory(issue jira.Issue, isMember bool, sprintID int, storyPointsField string) issueHistory {
+	history := issueHistory{Issue: issue}
+
+	entries := append([]jira.ChangelogEntry{}, issue.Changelog...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Created.Before(entries[j].Created)
+	})
+
+	for _, entry := range entries {
+		for _, item := range entry.Items {
+			switch {
+			case item.Field == "Sprint":
+				history.SprintChanges = append(history.SprintChanges, fieldChange{entry.Created, item.From, item.To})
+			case item.FieldID == "status":
+				history.StatusChanges = append(history.StatusChanges, fieldChange{entry.Created, item.From, item.To})
+			case storyPointsField != "" && item.FieldID == storyPointsField:
+				history.PointsChanges = append(history.PointsChanges, fieldChange{entry.Created, item.FromString, item.ToString})
+			}
+		}
+	}
+
+	// the sprint field isn't fetched, so the current sprints come from the last
+	// change, or from whether the issue was found in the sprint
+	if len(history.SprintChanges) > 0 {
+		history.Sprints = history.SprintChanges[len(history.SprintChanges)-1].To
+	} else if isMember {
+		history.Sprints = strconv.Itoa(sprintID)
+	}
+
+	return history
+}
+
+// valueAt returns the value of a field at a point in time, given its current
+// value and its changes in chronological order
+func valueAt(current string, changes []fieldChange, t time.Time) string {
+	value := current
+	for i := len(changes) - 1; i >= 0 && changes[i].Time.After(t); i-- {
+		value = changes[i].From
+	}
+
+	return value
+}
+
+// hasSprint checks whether a comma-separated list of sprint IDs contains a sprint
+func hasSprint(sprints string, sprintID int) bool {
+	for _, id := range strings.Split(sprints, ",") {
+		if strings.TrimSpace(id) == strconv.Itoa(sprintID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (history issueHistory) inSprintAt(sprintID int, t time.Time) bool {
+	if !history.Issue.Created.IsZero() && history.Issue.Created.After(t) {
+		return false
+	}
+
+	return hasSprint(valueAt(history.Sprints, history.SprintChanges, t), sprintID)
+}
+
+func (history issueHistory) doneAt(doneStatuses map[string]bool, t time.Time) bool {
+	return doneStatuses[valueAt(history.Issue.StatusID, history.StatusChanges, t)]
+}
+
+// valueAt returns the issue's story points at a point in time, or 1 if issues
+// are counted instead of story points
+func (history issueHistory) valueAt(storyPointsField string, t time.Time) float64 {
+	if storyPointsField == "" {
+		return 1
+	}
+
+	current := ""
+	if history.Issue.StoryPoints != 0 {
+		current = formatPoints(history.Issue.StoryPoints)
+	}
+	points, _ := strconv.ParseFloat(valueAt(current, history.PointsChanges, t), 64)
+
+	return points
+}
+
+// sprintHistory holds the history of every issue that was in a sprint at some point
+type sprintHistory struct {
+	Sprint           jira.Sprint
+	StoryPointsField string
+	Issues           []issueHistory
+	DoneStatuses     map[string]bool
+}
+
+// getSprintHistory fetches the changelogs of the issues that are in the sprint,
+// and of the issues on the sprint's board that were removed from it
+func getSprintHistory(sprint jira.Sprint, storyPointsField string) (sprintHistory, error) {
+	histories, err := getSprintHistories([]jira.Sprint{sprint}, storyPointsField)
+	if err != nil {
+		return sprintHistory{}, err
+	}
+
+	return histories[0], nil
+}
+
+// getSprintHistories fetches the history of several sprints, fetching the
+// statuses and the issues on their boards only once
+func getSprintHistories(sprints []jira.Sprint, storyPointsField string) ([]sprintHistory, error) {
+	statuses, err := jiraClient.GetStatuses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statuses: %s", err)
+	}
+	doneStatuses := map[string]bool{}
+	for _, status := range statuses {
+		if status.CategoryKey == "done" {
+			doneStatuses[status.ID] = true
+		}
+	}
+
+	boardIssues, err := getBoardIssuesSinceStart(sprints, storyPointsField)
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make([]sprintHistory, len(sprints))
+	for i, sprint := range sprints {
+		history := sprintHistory{
+			Sprint:           sprint,
+			StoryPointsField: storyPointsField,
+			DoneStatuses:     doneStatuses,
+		}
+
+		members, err := jiraClient.SearchIssuesWithChangelog(fmt.Sprintf("sprint = %d", sprint.ID), storyPointsField)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issues in sprint '%s': %s", sprint.Name, err)
+		}
+		seen := map[string]bool{}
+		for _, issue := range members {
+			seen[issue.Key] = true
+			history.Issues = append(history.Issues, newIssueHistory(issue, true, sprint.ID, storyPointsField))
+		}
+
+		// issues removed from the sprint are no longer matched by 'sprint = ID', so
+		// look for them among the issues on the board
+		if !sprint.StartDate.IsZero() {
+			for _, issue := range boardIssues[sprint.BoardID] {
+				if seen[issue.Key] {
+					continue
+				}
+
+				issueHistory := newIssueHistory(issue, false, sprint.ID, storyPointsField)
+				for _, change := range issueHistory.SprintChanges {
+					if hasSprint(change.From, sprint.ID) || hasSprint(change.To, sprint.ID) {
+						history.Issues = append(history.Issues, issueHistory)
+						break
+					}
+				}
+			}
+		}
+
+		histories[i] = history
+	}
+
+	return histories, nil
+}
+
+// getBoardIssuesSinceStart fetches the issues on the boards of the sprints that
+// were updated since the earliest sprint on each board started, with their
+// changelogs, keyed by board ID
+func getBoardIssuesSinceStart(sprints []jira.Sprint, storyPointsField string) (map[int][]jira.Issue, error) {
+	since := map[int]time.Time{}
+	for _, sprint := range sprints {
+		if sprint.BoardID == 0 || sprint.StartDate.IsZero() {
+			continue
+		}
+		if start, ok := since[sprint.BoardID]; !ok || sprint.StartDate.Before(start) {
+			since[sprint.BoardID] = sprint.StartDate
+		}
+	}
+
+	boardIssues := map[int][]jira.Issue{}
+	for boardID, start := range since {
+		configuration, err := jiraClient.GetBoardConfiguration(boardID)
+		if err != nil || configuration.FilterID == "" {
+			continue
+		}
+
+		jql := fmt.Sprintf("filter = %s AND updated >= \"%s\"", configuration.FilterID, start.Format("2006-01-02 15:04"))
+		issues, err := jiraClient.SearchIssuesWithChangelog(jql, storyPointsField)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issues removed from sprints: %s", err)
+		}
+		boardIssues[boardID] = issues
+	}
+
+	return boardIssues, nil
+}
+
+// totalsAt returns the scope of the sprint and the part of it that is done at
+// a point in time
+func (history sprintHistory) totalsAt(t time.Time) (float64, float64) {
+	var scope, done float64
+	for _, issue := range history.Issues {
+		if !issue.inSprintAt(history.Sprint.ID, t) {
+			continue
+		}
+
+		value := issue.valueAt(history.StoryPointsField, t)
+		scope += value
+		if issue.doneAt(history.DoneStatuses, t) {
+			done += value
+		}
+	}
+
+	return scope, done
+}
+
+// scopeChange is an issue added to or removed from a sprint after it started,
+// or an estimate changed while the issue was in the sprint
+type scopeChange struct {
+	Time   time.Time
+	Key    string
+	Change string
+	Value  float64
+}
+
+// scopeChanges returns the changes to the sprint's scope between the sprint's
+// start and a point in time, in chronological order
+func (history sprintHistory) scopeChanges(end time.Time) []scopeChange {
+	start := history.Sprint.StartDate
+	sprintID := history.Sprint.ID
+	changes := []scopeChange{}
+
+	for _, issue := range history.Issues {
+		created := issue.Issue.Created
+		if created.After(start) && !created.After(end) && issue.inSprintAt(sprintID, created) {
+			changes = append(changes, scopeChange{created, issue.Issue.Key, "added", issue.valueAt(history.StoryPointsField, created)})
+		}
+
+		for _, change := range issue.SprintChanges {
+			if !change.Time.After(start) || change.Time.After(end) {
+				continue
+			}
+
+			wasIn, isIn := hasSprint(change.From, sprintID), hasSprint(change.To, sprintID)
+			switch {
+			case !wasIn && isIn:
+				changes = append(changes, scopeChange{change.Time, issue.Issue.Key, "added", issue.valueAt(history.StoryPointsField, change.Time)})
+			case wasIn && !isIn:
+				changes = append(changes, scopeChange{change.Time, issue.Issue.Key, "removed", -issue.valueAt(history.StoryPointsField, change.Time)})
+			}
+		}
+
+		for _, change := range issue.PointsChanges {
+			if !change.Time.After(start) || change.Time.After(end) || !issue.inSprintAt(sprintID, change.Time) {
+				continue
+			}
+
+			from, _ := strconv.ParseFloat(change.From, 64)
+			to, _ := strconv.ParseFloat(change.To, 64)
+			if from != to {
+				changes = append(changes, scopeChange{change.Time, issue.Issue.Key, "estimate changed", to - from})
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Time.Before(changes[j].Time)
+	})
+
+	return changes
+}
+
+// endTime returns the end of the sprint's data: when it was completed, or now
+// if it's still running
+func (history sprintHistory) endTime() time.Time {
+	if !history.Sprint.CompleteDate.IsZero() {
+		return history.Sprint.CompleteDate
+	}
+
+	return time.Now()
+}
@@ -0,0 +1,88 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package sprint
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var listState string
+
+func newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [BOARD] [--state active|future|closed]",
+		Short: "List the sprints of a board",
+		Long:  `List the sprints of a board by its ID or name, or of the default board. Sprints can be filtered by state.`,
+		Args:  cobra.MaximumNArgs(1),
+		Example: `# List all sprints of a board
+jira sprint list 42
+
+# List the active and future sprints of the default board
+jira sprint list --state active,future`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			if len(args) > 0 {
+				boardName = args[0]
+			}
+			return listSprints()
+		},
+	}
+
+	cmd.Flags().StringVarP(&listState, "state", "s", "", "only list sprints in the state: active, future, or closed (comma-separated)")
+	util.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func listSprints() error {
+	board, err := resolveBoard()
+	if err != nil {
+		return err
+	}
+
+	sprints, err := jiraClient.GetSprints(board.ID, listState)
+	if err != nil {
+		return fmt.Errorf("failed to get sprints: %s", err)
+	}
+
+	if len(sprints) == 0 && util.IsTableOutput() {
+		fmt.Println("No sprints found.")
+		return nil
+	}
+
+	return util.PrintList(sprints, sprintColumns)
+}
+
+// sprintColumns are the columns of sprint lists
+var sprintColumns = []util.Column[jira.Sprint]{
+	{Header: "ID", Field: "id", Value: func(sprint jira.Sprint) string { return strconv.Itoa(sprint.ID) }},
+	{Header: "Name", Field: "name", Value: func(sprint jira.Sprint) string { return sprint.Name }},
+	{Header: "State", Field: "state", Value: func(sprint jira.Sprint) string { return sprint.State }},
+	{Header: "Start", Field: "startDate", Value: func(sprint jira.Sprint) string { return formatDay(sprint.StartDate) }},
+	{Header: "End", Field: "endDate", Value: func(sprint jira.Sprint) string { return formatDay(sprint.EndDate) }},
+	{Header: "Goal", Field: "goal", Value: func(sprint jira.Sprint) string { return sprint.Goal }},
+}
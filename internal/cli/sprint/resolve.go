@@ -0,0 +1,143 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package sprint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/viper"
+)
+
+// boardName is the --board flag shared by the sprint subcommands
+var boardName string
+
+// resolveBoard returns the board passed in with --board, or the default board
+func resolveBoard() (jira.Board, error) {
+	name := boardName
+	if name == "" {
+		name = viper.GetString(string(util.DefaultBoardIDKey))
+	}
+	if name == "" {
+		return jira.Board{}, fmt.Errorf("missing --board and no default board is configured")
+	}
+
+	board, err := jiraClient.FindBoard(name)
+	if err != nil {
+		return jira.Board{}, fmt.Errorf("failed to get board: %s", err)
+	}
+
+	return board, nil
+}
+
+// resolveSprint returns a sprint by its ID, or the active sprint of the board if
+// sprintNameOrID is 'current' or empty, or a sprint of the board by name
+func resolveSprint(sprintNameOrID string) (jira.Sprint, error) {
+	if sprintID, err := strconv.Atoi(sprintNameOrID); err == nil {
+		sprint, err := jiraClient.GetSprint(sprintID)
+		if err != nil {
+			return jira.Sprint{}, fmt.Errorf("failed to get sprint %d: %s", sprintID, err)
+		}
+		return sprint, nil
+	}
+
+	board, err := resolveBoard()
+	if err != nil {
+		return jira.Sprint{}, err
+	}
+
+	if sprintNameOrID == "" || sprintNameOrID == "current" {
+		sprints, err := jiraClient.GetSprints(board.ID, "active")
+		if err != nil {
+			return jira.Sprint{}, fmt.Errorf("failed to get sprints of board %d: %s", board.ID, err)
+		}
+
+		switch len(sprints) {
+		case 0:
+			return jira.Sprint{}, fmt.Errorf("board '%s' has no active sprint", board.Name)
+		case 1:
+			return sprints[0], nil
+		default:
+			return jira.Sprint{}, fmt.Errorf("board '%s' has several active sprints, use one of the sprint IDs instead: %s", board.Name, sprintIDs(sprints))
+		}
+	}
+
+	sprints, err := jiraClient.GetSprints(board.ID, "")
+	if err != nil {
+		return jira.Sprint{}, fmt.Errorf("failed to get sprints of board %d: %s", board.ID, err)
+	}
+
+	var matches []jira.Sprint
+	for _, sprint := range sprints {
+		if strings.EqualFold(sprint.Name, sprintNameOrID) {
+			matches = append(matches, sprint)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return jira.Sprint{}, fmt.Errorf("no sprint named '%s' on board '%s'", sprintNameOrID, board.Name)
+	case 1:
+		return matches[0], nil
+	default:
+		return jira.Sprint{}, fmt.Errorf("several sprints are named '%s', use one of the sprint IDs instead: %s", sprintNameOrID, sprintIDs(matches))
+	}
+}
+
+func sprintIDs(sprints []jira.Sprint) string {
+	ids := make([]string, len(sprints))
+	for i, sprint := range sprints {
+		ids[i] = strconv.Itoa(sprint.ID)
+	}
+
+	return strings.Join(ids, ", ")
+}
+
+func formatDate(sprint jira.Sprint) string {
+	const layout = "2006-01-02"
+
+	switch {
+	case sprint.StartDate.IsZero():
+		return ""
+	case !sprint.CompleteDate.IsZero():
+		return fmt.Sprintf("%s - %s", sprint.StartDate.Format(layout), sprint.CompleteDate.Format(layout))
+	default:
+		return fmt.Sprintf("%s - %s", sprint.StartDate.Format(layout), sprint.EndDate.Format(layout))
+	}
+}
+
+// formatDay formats the day of a sprint date, or "" if it isn't set
+func formatDay(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format("2006-01-02")
+}
+
+func formatPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package sprint
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+func newShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [SPRINT|current] [--board BOARD]",
+		Short: "Show the issues in a sprint",
+		Long: `Show the issues in a sprint grouped by status, with story point totals.
+
+SPRINT is a sprint ID, or a sprint name on the board. 'current' (the default) is the active sprint of the board passed in with --board, or of the default board. Story points are read from the configured story points field, or from the board's estimation field.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `# Show the active sprint of the default board
+jira sprint show
+
+# Show a sprint by ID
+jira sprint show 123
+
+# Show the active sprint of another board
+jira sprint show current --board "Team board"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			sprintNameOrID := "current"
+			if len(args) > 0 {
+				sprintNameOrID = args[0]
+			}
+			return showSprint(sprintNameOrID)
+		},
+	}
+
+	cmd.Flags().StringVarP(&boardName, "board", "b", "", "board of the sprint, by ID or name (default is the configured default board)")
+	util.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func showSprint(sprintNameOrID string) error {
+	sprint, err := resolveSprint(sprintNameOrID)
+	if err != nil {
+		return err
+	}
+
+	field := util.StoryPointsField(&jiraClient, sprint.BoardID)
+	issues, err := jiraClient.GetSprintIssues(sprint.ID, field)
+	if err != nil {
+		return fmt.Errorf("failed to get issues in sprint: %s", err)
+	}
+
+	return util.PrintDataWithList(sprintIssues{Sprint: sprint, Issues: issues}, issues, util.IssueColumns, func() error {
+		printSprintIssues(sprint, issues, field)
+		return nil
+	})
+}
+
+// sprintIssues is a sprint with its issues
+type sprintIssues struct {
+	jira.Sprint
+	Issues []jira.Issue `json:"issues"`
+}
+
+func printSprintIssues(sprint jira.Sprint, issues []jira.Issue, field string) {
+	fmt.Printf("Sprint: %s (ID %d, %s)\n", sprint.Name, sprint.ID, sprint.State)
+	if dates := formatDate(sprint); dates != "" {
+		fmt.Printf("Dates: %s\n", dates)
+	}
+	if sprint.Goal != "" {
+		fmt.Printf("Goal: %s\n", sprint.Goal)
+	}
+
+	// group issues by status, ordered by status category
+	categoryOrder := map[string]int{"new": 0, "indeterminate": 1, "done": 2}
+	groups := map[string][]jira.Issue{}
+	var statuses []string
+	for _, issue := range issues {
+		if _, ok := groups[issue.Status]; !ok {
+			statuses = append(statuses, issue.Status)
+		}
+		groups[issue.Status] = append(groups[issue.Status], issue)
+	}
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return categoryOrder[groups[statuses[i]][0].StatusCategoryKey] < categoryOrder[groups[statuses[j]][0].StatusCategoryKey]
+	})
+
+	var totalPoints, donePoints float64
+	doneCount := 0
+	for _, status := range statuses {
+		var points float64
+		for _, issue := range groups[status] {
+			points += issue.StoryPoints
+		}
+		fmt.Printf("\n%s (%s)\n", status, formatTotals(len(groups[status]), points, field))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, issue := range groups[status] {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t\n", issue.Key, issue.Title, issue.Assignee, formatIssuePoints(issue, field))
+		}
+		w.Flush()
+
+		totalPoints += points
+		if groups[status][0].StatusCategoryKey == "done" {
+			donePoints += points
+			doneCount += len(groups[status])
+		}
+	}
+
+	fmt.Printf("\nTotal: %s, done: %s\n", formatTotals(len(issues), totalPoints, field), formatTotals(doneCount, donePoints, field))
+}
+
+func formatTotals(count int, points float64, field string) string {
+	if field == "" {
+		return fmt.Sprintf("%d issue(s)", count)
+	}
+
+	return fmt.Sprintf("%d issue(s), %s point(s)", count, formatPoints(points))
+}
+
+func formatIssuePoints(issue jira.Issue, field string) string {
+	if field == "" || issue.StoryPoints == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s pts", formatPoints(issue.StoryPoints))
+}
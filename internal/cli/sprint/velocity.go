@@ -0,0 +1,246 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package sprint
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var velocityLast int
+
+func newVelocityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "velocity [BOARD] [--last N]",
+		Short: "Show the velocity of a board's team across closed sprints",
+		Long: `Show the committed and completed story points of the last closed sprints of a board, with their average and standard deviation and a bar chart.
+
+Committed is the scope of the sprint when it started, and completed is the part of the sprint that was done when it was completed, both reconstructed from the changelogs of the sprint's issues. Story points are read from the configured story points field, the board's estimation field, or a field named like 'Story Points'. Issues are counted instead when none is found.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `# Show the velocity of the default board over the last 6 sprints
+jira sprint velocity
+
+# Show the velocity of a board over the last 10 sprints
+jira sprint velocity "Team board" --last 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			if len(args) > 0 {
+				boardName = args[0]
+			}
+			return showVelocity()
+		},
+	}
+
+	cmd.Flags().IntVarP(&velocityLast, "last", "n", 6, "number of closed sprints to include")
+
+	return cmd
+}
+
+// sprintVelocity is the committed and completed scope of a closed sprint
+type sprintVelocity struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	Committed float64 `json:"committed"`
+	Completed float64 `json:"completed"`
+}
+
+// velocityReport is the velocity of a board across its last closed sprints
+type velocityReport struct {
+	BoardID          int              `json:"boardId"`
+	Board            string           `json:"board"`
+	Unit             string           `json:"unit"`
+	Sprints          []sprintVelocity `json:"sprints"`
+	CommittedAverage float64          `json:"committedAverage"`
+	CompletedAverage float64          `json:"completedAverage"`
+	CommittedStddev  float64          `json:"committedStddev"`
+	CompletedStddev  float64          `json:"completedStddev"`
+}
+
+// sprintVelocityColumns are the columns of the sprints in CSV and TSV output
+var sprintVelocityColumns = []util.Column[sprintVelocity]{
+	{Header: "ID", Field: "id", Value: func(velocity sprintVelocity) string { return strconv.Itoa(velocity.ID) }},
+	{Header: "Sprint", Field: "name", Value: func(velocity sprintVelocity) string { return velocity.Name }},
+	{Header: "Committed", Field: "committed", Value: func(velocity sprintVelocity) string { return formatPoints(velocity.Committed) }},
+	{Header: "Completed", Field: "completed", Value: func(velocity sprintVelocity) string { return formatPoints(velocity.Completed) }},
+}
+
+func showVelocity() error {
+	if velocityLast < 1 {
+		return fmt.Errorf("--last must be at least 1")
+	}
+
+	board, err := resolveBoard()
+	if err != nil {
+		return err
+	}
+
+	sprints, err := jiraClient.GetSprints(board.ID, "closed")
+	if err != nil {
+		return fmt.Errorf("failed to get sprints: %s", err)
+	}
+	if len(sprints) == 0 && util.IsTableOutput() {
+		fmt.Println("No closed sprints found.")
+		return nil
+	}
+
+	// keep the most recently completed sprints, oldest first
+	sort.SliceStable(sprints, func(i, j int) bool {
+		return sprints[i].CompleteDate.Before(sprints[j].CompleteDate)
+	})
+	if len(sprints) > velocityLast {
+		sprints = sprints[len(sprints)-velocityLast:]
+	}
+
+	field := util.StoryPointsField(&jiraClient, board.ID)
+	var startedSprints []jira.Sprint
+	for _, sprint := range sprints {
+		if !sprint.StartDate.IsZero() {
+			startedSprints = append(startedSprints, sprint)
+		}
+	}
+
+	// the board's issues are fetched once for all the sprints
+	histories, err := getSprintHistories(startedSprints, field)
+	if err != nil {
+		return fmt.Errorf("failed to get history of sprints: %s", err)
+	}
+
+	velocities := []sprintVelocity{}
+	for _, history := range histories {
+		committed, _ := history.totalsAt(history.Sprint.StartDate)
+		_, completed := history.totalsAt(history.endTime())
+		velocities = append(velocities, sprintVelocity{
+			ID:        history.Sprint.ID,
+			Name:      history.Sprint.Name,
+			Committed: committed,
+			Completed: completed,
+		})
+	}
+
+	unit := "points"
+	if field == "" {
+		unit = "issues"
+	}
+
+	report := velocityReport{
+		BoardID: board.ID,
+		Board:   board.Name,
+		Unit:    unit,
+		Sprints: velocities,
+	}
+	committed := make([]float64, len(velocities))
+	completed := make([]float64, len(velocities))
+	for i, velocity := range velocities {
+		committed[i] = velocity.Committed
+		completed[i] = velocity.Completed
+	}
+	report.CommittedAverage, report.CommittedStddev = meanAndStddev(committed)
+	report.CompletedAverage, report.CompletedStddev = meanAndStddev(completed)
+
+	return util.PrintDataWithList(report, velocities, sprintVelocityColumns, func() error {
+		fmt.Printf("Velocity: %s (%s)\n\n", board.Name, unit)
+		printVelocityTable(report)
+		fmt.Println()
+		printVelocityChart(velocities)
+		return nil
+	})
+}
+
+func printVelocityTable(report velocityReport) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Sprint\tCommitted\tCompleted\tCompleted %\t")
+	for _, velocity := range report.Sprints {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", velocity.Name, formatPoints(velocity.Committed), formatPoints(velocity.Completed), formatPercent(velocity.Completed, velocity.Committed))
+	}
+	fmt.Fprintln(w, "\t\t\t\t")
+
+	fmt.Fprintf(w, "Average\t%s\t%s\t%s\t\n", formatRounded(report.CommittedAverage), formatRounded(report.CompletedAverage), formatPercent(report.CompletedAverage, report.CommittedAverage))
+	fmt.Fprintf(w, "Std dev\t%s\t%s\t\t\n", formatRounded(report.CommittedStddev), formatRounded(report.CompletedStddev))
+	w.Flush()
+}
+
+func printVelocityChart(velocities []sprintVelocity) {
+	const barWidth = 40
+
+	maxValue := 0.0
+	nameWidth := 0
+	for _, velocity := range velocities {
+		maxValue = math.Max(maxValue, math.Max(velocity.Committed, velocity.Completed))
+		nameWidth = max(nameWidth, len([]rune(velocity.Name)))
+	}
+	if maxValue == 0 {
+		maxValue = 1
+	}
+	nameWidth = min(nameWidth, 20)
+
+	bar := func(value float64, char string) string {
+		return strings.Repeat(char, int(math.Round(value/maxValue*barWidth)))
+	}
+
+	for _, velocity := range velocities {
+		name := util.Pad(util.Truncate(velocity.Name, nameWidth), nameWidth)
+		fmt.Printf("%s  %s %s\n", name, util.Colorize(bar(velocity.Committed, "="), util.ColorGray), formatPoints(velocity.Committed))
+		fmt.Printf("%s  %s %s\n", strings.Repeat(" ", nameWidth), util.Colorize(bar(velocity.Completed, "#"), util.ColorGreen), formatPoints(velocity.Completed))
+	}
+	fmt.Printf("\n  = committed   # completed\n")
+}
+
+// meanAndStddev returns the mean and the population standard deviation of the values
+func meanAndStddev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+
+	var squares float64
+	for _, value := range values {
+		squares += (value - mean) * (value - mean)
+	}
+
+	return mean, math.Sqrt(squares / float64(len(values)))
+}
+
+func formatRounded(value float64) string {
+	return formatPoints(math.Round(value*10) / 10)
+}
+
+func formatPercent(part float64, total float64) string {
+	if total == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.0f%%", part/total*100)
+}
@@ -34,9 +34,12 @@ func NewCommand() *cobra.Command {
 	versionCmd := &cobra.Command{
 		Use:   "version",
 		Short: "Show the version for the jira CLI tool",
+		Annotations: map[string]string{
+			util.TableOutputOnlyAnnotation: "",
+		},
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			// override the root's PersistentPreRunE since we don't need to init config
-			return nil
+			return util.ValidateOutputFormat(cmd)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			buildInfo, ok := debug.ReadBuildInfo()
@@ -0,0 +1,62 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package workflow
+
+import (
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+)
+
+var jiraClient jira.Jira
+
+// NewCommand creates and returns the workflow command
+func NewCommand() *cobra.Command {
+	workflowCmd := &cobra.Command{
+		Use:   "workflow",
+		Short: "Inspect Jira workflows",
+		Long:  `Inspect the statuses and transitions of the workflows used by Jira projects.`,
+		Example: `# Show the workflow of a project's default issue type
+jira workflow show PROJ
+
+# Render the workflow for bugs as a Graphviz diagram
+jira workflow show PROJ --issue-type Bug --graph dot | dot -Tpng -o workflow.png`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := util.RunRootPreRun(cmd, args); err != nil {
+				return err
+			}
+
+			var err error
+			jiraClient, err = util.InitJiraConfig()
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	// Add subcommands
+	workflowCmd.AddCommand(newShowCommand())
+
+	return workflowCmd
+}
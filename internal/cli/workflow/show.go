@@ -0,0 +1,368 @@
+/*
+Copyright © 2025 Bach Nguyen <69bnguyen@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package workflow
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/eeternalsadness/jira/internal/util"
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	issueTypeName string
+	issueID       string
+	graphFormat   string
+)
+
+// workflowView is a workflow with the extra information needed to render it
+type workflowView struct {
+	Workflow        jira.Workflow
+	Statuses        map[string]jira.Status
+	CurrentStatusID string
+	Reachable       map[string]bool
+}
+
+func newShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [PROJECT] [--issue-type ISSUE_TYPE] [--issue ISSUE_ID] [--graph text|dot|mermaid]",
+		Short: "Show a project's workflow",
+		Long: `Show the statuses and transitions of the workflow used by an issue type in a project, as a text adjacency list, a Graphviz DOT graph, or a Mermaid state diagram.
+
+Statuses are colored by status category. With --issue, the project and issue type are taken from the issue, and the statuses reachable from the issue's current status are highlighted. Without a project or issue, the default project is used.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `# Show the workflow of a project's default issue type
+jira workflow show PROJ
+
+# Show the workflow for bugs as a Mermaid diagram
+jira workflow show PROJ --issue-type Bug --graph mermaid
+
+# Highlight where an issue can go from its current status
+jira workflow show --issue PROJ-123 --graph dot | dot -Tsvg -o workflow.svg`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			project := viper.GetString(string(util.DefaultProjectIDKey))
+			if len(args) > 0 {
+				project = args[0]
+			}
+			return showWorkflow(project)
+		},
+	}
+
+	cmd.Flags().StringVarP(&issueTypeName, "issue-type", "t", "", "issue type whose workflow to show, by name or ID")
+	cmd.Flags().StringVarP(&issueID, "issue", "i", "", "highlight the statuses reachable from this issue's current status")
+	cmd.Flags().StringVarP(&graphFormat, "graph", "g", "text", "output format: text, dot, or mermaid")
+
+	return cmd
+}
+
+func showWorkflow(project string) error {
+	view := workflowView{}
+	issueTypeID := ""
+
+	if issueID != "" {
+		issue, err := jiraClient.GetIssueByID(issueID)
+		if err != nil {
+			return fmt.Errorf("failed to get issue %s: %s", issueID, err)
+		}
+		project = issue.ProjectID
+		issueTypeID = issue.IssueTypeID
+		view.CurrentStatusID = issue.StatusID
+	}
+
+	if project == "" {
+		return fmt.Errorf("missing project and no default project is configured")
+	}
+
+	projectInfo, err := jiraClient.GetProject(project)
+	if err != nil {
+		return fmt.Errorf("failed to get project %s: %s", project, err)
+	}
+
+	if issueTypeName != "" {
+		issueType, err := util.MatchIssueType(projectInfo.IssueTypes, issueTypeName)
+		if err != nil {
+			return fmt.Errorf("failed to find issue type in project %s: %s", projectInfo.Key, err)
+		}
+		issueTypeID = issueType.ID
+	}
+
+	view.Workflow, err = jiraClient.GetWorkflow(projectInfo.ID, issueTypeID)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow for project %s: %s", projectInfo.Key, err)
+	}
+
+	statuses, err := jiraClient.GetStatuses()
+	if err != nil {
+		return fmt.Errorf("failed to get statuses: %s", err)
+	}
+	view.Statuses = map[string]jira.Status{}
+	for _, status := range statuses {
+		view.Statuses[status.ID] = status
+	}
+
+	if view.CurrentStatusID != "" {
+		view.Reachable = view.Workflow.ReachableFrom(view.CurrentStatusID)
+	}
+
+	// --graph picks how the workflow is drawn in table output
+	return util.PrintData(view.Workflow, func() error {
+		switch graphFormat {
+		case "text":
+			fmt.Print(renderText(view))
+		case "dot":
+			fmt.Print(renderDOT(view))
+		case "mermaid":
+			fmt.Print(renderMermaid(view))
+		default:
+			return fmt.Errorf("invalid graph format '%s', must be one of: text, dot, mermaid", graphFormat)
+		}
+		return nil
+	})
+}
+
+// sortedStatuses returns the workflow's statuses ordered by status category
+// (to do, in progress, done), then by name
+func (view workflowView) sortedStatuses() []jira.WorkflowStatus {
+	categoryOrder := map[string]int{"new": 0, "indeterminate": 1, "done": 2}
+
+	statuses := append([]jira.WorkflowStatus{}, view.Workflow.Statuses...)
+	sort.SliceStable(statuses, func(i, j int) bool {
+		iOrder, ok := categoryOrder[view.Statuses[statuses[i].ID].CategoryKey]
+		if !ok {
+			iOrder = len(categoryOrder)
+		}
+		jOrder, ok := categoryOrder[view.Statuses[statuses[j].ID].CategoryKey]
+		if !ok {
+			jOrder = len(categoryOrder)
+		}
+
+		if iOrder != jOrder {
+			return iOrder < jOrder
+		}
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
+
+func (view workflowView) statusName(statusID string) string {
+	if status, ok := view.Workflow.StatusByID(statusID); ok {
+		return status.Name
+	}
+
+	return statusID
+}
+
+func (view workflowView) globalTransitions() []jira.WorkflowTransition {
+	var transitions []jira.WorkflowTransition
+	for _, transition := range view.Workflow.Transitions {
+		if len(transition.From) == 0 && transition.Type != "initial" {
+			transitions = append(transitions, transition)
+		}
+	}
+
+	return transitions
+}
+
+func (view workflowView) initialTransitions() []jira.WorkflowTransition {
+	var transitions []jira.WorkflowTransition
+	for _, transition := range view.Workflow.Transitions {
+		if transition.Type == "initial" {
+			transitions = append(transitions, transition)
+		}
+	}
+
+	return transitions
+}
+
+// directedTransitions returns the transitions that start from the status,
+// excluding global ones
+func (view workflowView) directedTransitions(statusID string) []jira.WorkflowTransition {
+	var transitions []jira.WorkflowTransition
+	for _, transition := range view.Workflow.TransitionsFrom(statusID) {
+		if len(transition.From) > 0 {
+			transitions = append(transitions, transition)
+		}
+	}
+
+	return transitions
+}
+
+func renderText(view workflowView) string {
+	categoryColors := map[string]string{
+		"new":           util.ColorGray,
+		"indeterminate": util.ColorBlue,
+		"done":          util.ColorGreen,
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Workflow: %s\n", view.Workflow.Name))
+
+	for _, status := range view.sortedStatuses() {
+		category := view.Statuses[status.ID]
+		line := fmt.Sprintf("%s [%s]", status.Name, category.Category)
+		switch {
+		case status.ID == view.CurrentStatusID:
+			line = util.Colorize(line+" (current)", util.ColorBold+util.ColorYellow)
+		case view.Reachable[status.ID]:
+			line = util.Colorize(line+" (reachable)", util.ColorBold+categoryColors[category.CategoryKey])
+		default:
+			line = util.Colorize(line, categoryColors[category.CategoryKey])
+		}
+		builder.WriteString(fmt.Sprintf("\n%s\n", line))
+
+		for _, transition := range view.directedTransitions(status.ID) {
+			builder.WriteString(fmt.Sprintf("  -> %s (%s)\n", view.statusName(transition.To), transition.Name))
+		}
+	}
+
+	if globalTransitions := view.globalTransitions(); len(globalTransitions) > 0 {
+		builder.WriteString("\nFrom any status\n")
+		for _, transition := range globalTransitions {
+			builder.WriteString(fmt.Sprintf("  -> %s (%s)\n", view.statusName(transition.To), transition.Name))
+		}
+	}
+
+	return builder.String()
+}
+
+func renderDOT(view workflowView) string {
+	categoryColors := map[string]string{
+		"new":           "#dfe1e6",
+		"indeterminate": "#deebff",
+		"done":          "#e3fcef",
+	}
+	quote := func(str string) string {
+		return fmt.Sprintf("\"%s\"", strings.ReplaceAll(str, "\"", "\\\""))
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("digraph %s {\n", quote(view.Workflow.Name)))
+	builder.WriteString("  rankdir=LR;\n")
+	builder.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	builder.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n\n")
+
+	for _, status := range view.sortedStatuses() {
+		color, ok := categoryColors[view.Statuses[status.ID].CategoryKey]
+		if !ok {
+			color = "#ffffff"
+		}
+
+		attributes := fmt.Sprintf("label=%s, fillcolor=%s", quote(status.Name), quote(color))
+		switch {
+		case status.ID == view.CurrentStatusID:
+			attributes += ", color=\"#ff5630\", penwidth=3"
+		case view.Reachable[status.ID]:
+			attributes += ", color=\"#0052cc\", penwidth=2"
+		}
+		builder.WriteString(fmt.Sprintf("  %s [%s];\n", quote("s"+status.ID), attributes))
+	}
+	builder.WriteString("\n")
+
+	if initialTransitions := view.initialTransitions(); len(initialTransitions) > 0 {
+		builder.WriteString("  \"start\" [shape=point, style=filled, fillcolor=black, label=\"\"];\n")
+		for _, transition := range initialTransitions {
+			builder.WriteString(fmt.Sprintf("  \"start\" -> %s [label=%s];\n", quote("s"+transition.To), quote(transition.Name)))
+		}
+	}
+
+	for _, status := range view.sortedStatuses() {
+		for _, transition := range view.directedTransitions(status.ID) {
+			builder.WriteString(fmt.Sprintf("  %s -> %s [label=%s];\n", quote("s"+status.ID), quote("s"+transition.To), quote(transition.Name)))
+		}
+	}
+
+	if globalTransitions := view.globalTransitions(); len(globalTransitions) > 0 {
+		builder.WriteString("  \"any\" [label=\"Any status\", shape=plaintext, style=\"\"];\n")
+		for _, transition := range globalTransitions {
+			builder.WriteString(fmt.Sprintf("  \"any\" -> %s [label=%s, style=dashed];\n", quote("s"+transition.To), quote(transition.Name)))
+		}
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func renderMermaid(view workflowView) string {
+	label := func(str string) string {
+		return strings.NewReplacer("\"", "'", ":", " ").Replace(str)
+	}
+
+	var builder strings.Builder
+	builder.WriteString("stateDiagram-v2\n")
+	builder.WriteString("    direction LR\n")
+
+	for _, status := range view.sortedStatuses() {
+		builder.WriteString(fmt.Sprintf("    state \"%s\" as s%s\n", label(status.Name), status.ID))
+	}
+
+	for _, transition := range view.initialTransitions() {
+		builder.WriteString(fmt.Sprintf("    [*] --> s%s : %s\n", transition.To, label(transition.Name)))
+	}
+
+	for _, status := range view.sortedStatuses() {
+		for _, transition := range view.directedTransitions(status.ID) {
+			builder.WriteString(fmt.Sprintf("    s%s --> s%s : %s\n", status.ID, transition.To, label(transition.Name)))
+		}
+	}
+
+	if globalTransitions := view.globalTransitions(); len(globalTransitions) > 0 {
+		builder.WriteString("    state \"Any status\" as any\n")
+		for _, transition := range globalTransitions {
+			builder.WriteString(fmt.Sprintf("    any --> s%s : %s\n", transition.To, label(transition.Name)))
+		}
+	}
+
+	// color statuses by category, and highlight the current and reachable ones
+	builder.WriteString("\n")
+	builder.WriteString("    classDef new fill:#dfe1e6,color:#172b4d\n")
+	builder.WriteString("    classDef indeterminate fill:#deebff,color:#0747a6\n")
+	builder.WriteString("    classDef done fill:#e3fcef,color:#006644\n")
+	builder.WriteString("    classDef current stroke:#ff5630,stroke-width:3px\n")
+	builder.WriteString("    classDef reachable stroke:#0052cc,stroke-width:2px\n")
+
+	classes := map[string][]string{}
+	for _, status := range view.sortedStatuses() {
+		if categoryKey := view.Statuses[status.ID].CategoryKey; categoryKey != "" && categoryKey != "undefined" {
+			classes[categoryKey] = append(classes[categoryKey], "s"+status.ID)
+		}
+		switch {
+		case status.ID == view.CurrentStatusID:
+			classes["current"] = append(classes["current"], "s"+status.ID)
+		case view.Reachable[status.ID]:
+			classes["reachable"] = append(classes["reachable"], "s"+status.ID)
+		}
+	}
+	for _, class := range []string{"new", "indeterminate", "done", "current", "reachable"} {
+		if len(classes[class]) > 0 {
+			builder.WriteString(fmt.Sprintf("    class %s %s\n", strings.Join(classes[class], ","), class))
+		}
+	}
+
+	return builder.String()
+}
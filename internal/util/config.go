@@ -3,15 +3,33 @@ package util
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/eeternalsadness/jira/pkg/jira"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-func InitConfig(cmd *cobra.Command, cfgFile string) error {
+// ConfigOptionalAnnotation marks commands that can run without a config file or
+// with a missing active profile, e.g. because they create or fix them
+const ConfigOptionalAnnotation = "configOptional"
+
+// RunRootPreRun runs the root command's PersistentPreRunE for a command group
+// with a PersistentPreRunE of its own, since cobra only runs the closest one. The
+// root's is passed the command that runs so that its annotations are checked.
+func RunRootPreRun(cmd *cobra.Command, args []string) error {
+	root := cmd.Root()
+	if root == cmd || root.PersistentPreRunE == nil {
+		return nil
+	}
+
+	return root.PersistentPreRunE(cmd, args)
+}
+
+func InitConfig(cmd *cobra.Command, cfgFile string, profile string) error {
 	if cfgFile != "" {
 		// use config file from the flag.
 		viper.SetConfigFile(cfgFile)
@@ -29,28 +47,83 @@ func InitConfig(cmd *cobra.Command, cfgFile string) error {
 		cfgFile = fmt.Sprintf("%s/.config/jira/config.yaml", home)
 	}
 
+	if err := ValidateOutputFormat(cmd); err != nil {
+		return err
+	}
+
 	// make sure config dir is created
 	cfgDir := path.Dir(cfgFile)
 	if err := os.MkdirAll(cfgDir, 0o755); err != nil {
 		return err
 	}
 
+	// environment variables and flags take precedence over the config file
+	if err := bindEnv(); err != nil {
+		return err
+	}
+	if err := validateOverrides(); err != nil {
+		return err
+	}
+
 	if err := viper.ReadInConfig(); err != nil {
 		var configFileNotFoundErr viper.ConfigFileNotFoundError
-		if errors.As(err, &configFileNotFoundErr) {
-			// if running configure, set the config file
-			if cmd.Name() == "configure" {
-				viper.SetConfigFile(cfgFile)
-				return nil
-			}
-			fmt.Println("Config file not found! Please run 'jira configure' to configure your Jira credentials.")
+		if !errors.As(err, &configFileNotFoundErr) && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		// if running a command that doesn't need the config, set the config file
+		if _, ok := cmd.Annotations[ConfigOptionalAnnotation]; ok {
+			viper.SetConfigFile(cfgFile)
+			return loadSettings(cmd, profile)
 		}
+
+		// the config file isn't needed if the credentials are set in the environment
+		if !hasCredentials() {
+			fmt.Println("Config file not found! Please run 'jira configure' to configure your Jira credentials, or set JIRA_DOMAIN, JIRA_EMAIL, and JIRA_API_TOKEN.")
+			return err
+		}
+		if err := loadSettings(cmd, profile); err != nil {
+			return err
+		}
+
+		return viper.BindPFlags(cmd.Flags())
+	}
+
+	configFile, err := migrateConfigFile()
+	if err != nil {
+		return err
+	}
+	if unknownKeys := configFile.UnknownKeys(); len(unknownKeys) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: unknown keys in the config file are ignored: %s\n", strings.Join(unknownKeys, ", "))
+	}
+
+	if err := enforceConfigFilePermissions(); err != nil {
+		return err
+	}
+
+	if err := loadSettings(cmd, profile); err != nil {
 		return err
 	}
 
 	return viper.BindPFlags(cmd.Flags())
 }
 
+// validateOverrides checks the values set with environment variables and flags
+func validateOverrides() error {
+	for _, configKey := range ConfigKeys {
+		source := ConfigValueSource(configKey.Key)
+		if source != SourceEnv && source != SourceFlag {
+			continue
+		}
+
+		if err := configKey.Validate(viper.GetString(string(configKey.Key))); err != nil {
+			return fmt.Errorf("invalid value from %s: %s", source, err)
+		}
+	}
+
+	return nil
+}
+
 func InitJiraConfig() (jira.Jira, error) {
 	// get jira config
 	var jiraClient jira.Jira
@@ -58,6 +131,12 @@ func InitJiraConfig() (jira.Jira, error) {
 		return jira.Jira{}, fmt.Errorf("failed to read the config file '%s': %s", viper.ConfigFileUsed(), err)
 	}
 
+	token, err := ResolveToken()
+	if err != nil {
+		return jira.Jira{}, fmt.Errorf("failed to get the Jira API token: %s", err)
+	}
+	jiraClient.Token = token
+
 	return jiraClient, nil
 }
 
@@ -68,13 +147,15 @@ func ConfigJiraCredentials(domain *string, email *string, token *string) error {
 	if email == nil {
 		panic("email is nil")
 	}
-	if token == nil {
-		panic("token is nil")
-	}
 
-	viper.Set("domain", *domain)
-	viper.Set("email", *email)
-	viper.Set("token", *token)
+	values := map[ViperKey]any{
+		JiraDomainKey: *domain,
+		JiraEmailKey:  *email,
+	}
+	// a nil token means the token is read from a token helper
+	if token != nil {
+		values[JiraTokenKey] = *token
+	}
 
-	return viper.WriteConfig()
+	return SaveProfileValues(values)
 }
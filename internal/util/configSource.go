@@ -0,0 +1,99 @@
+package util
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// sources of configuration values, from highest to lowest precedence
+const (
+	SourceFlag    = "flag"
+	SourceEnv     = "env"
+	SourceRepo    = "repo"
+	SourceFile    = "file"
+	SourceDefault = "default"
+)
+
+// configFlags holds the global flags bound to configuration keys
+var configFlags = map[ViperKey]*pflag.Flag{}
+
+// AddConfigFlags adds a global flag for every configuration key, except maps
+func AddConfigFlags(cmd *cobra.Command) {
+	for _, configKey := range ConfigKeys {
+		if configKey.Type == KeyTypeMap {
+			continue
+		}
+		flags := cmd.PersistentFlags()
+		flags.String(configKey.FlagName(), "", fmt.Sprintf("override '%s': %s", configKey.Key, configKey.Description))
+
+		flag := flags.Lookup(configKey.FlagName())
+		configFlags[configKey.Key] = flag
+		cobra.CheckErr(viper.BindPFlag(string(configKey.Key), flag))
+	}
+}
+
+// bindEnv binds every configuration key, except maps, to its environment
+// variables
+func bindEnv() error {
+	for _, configKey := range ConfigKeys {
+		if configKey.Type == KeyTypeMap {
+			continue
+		}
+		if err := viper.BindEnv(append([]string{string(configKey.Key)}, configKey.Env()...)...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConfigValueSource returns where the value of a configuration key comes from:
+// a flag, an environment variable, the repository config file, the config file,
+// or nowhere
+func ConfigValueSource(key ViperKey) string {
+	if flag, ok := configFlags[key]; ok && flag.Changed {
+		return SourceFlag
+	}
+
+	if configKey, ok := LookupConfigKey(string(key)); ok {
+		for _, envVar := range configKey.Env() {
+			if os.Getenv(envVar) != "" {
+				return SourceEnv
+			}
+		}
+	}
+
+	if repoConfigKeys[key] {
+		return SourceRepo
+	}
+
+	if viper.InConfig(string(key)) {
+		return SourceFile
+	}
+
+	return SourceDefault
+}
+
+// ChangedConfigFlags returns the values of the configuration flags passed in
+func ChangedConfigFlags() map[ViperKey]string {
+	values := map[ViperKey]string{}
+	for key, flag := range configFlags {
+		if flag.Changed {
+			values[key] = flag.Value.String()
+		}
+	}
+
+	return values
+}
+
+// hasCredentials returns whether the Jira domain, email, and API token (or a way
+// to get it) are configured
+func hasCredentials() bool {
+	return viper.GetString(string(JiraDomainKey)) != "" &&
+		viper.GetString(string(JiraEmailKey)) != "" &&
+		(viper.GetString(string(JiraTokenKey)) != "" || HasTokenHelper())
+}
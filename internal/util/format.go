@@ -0,0 +1,116 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var formatTemplate string
+
+// AddFormatFlag adds the --format flag to a command that lists or gets items
+func AddFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&formatTemplate, "format", "", "print each item with a Go template, or a named template from the 'formats' setting with @NAME")
+}
+
+// formatColors are the colors available to the color template function
+var formatColors = map[string]string{
+	"red":    ColorRed,
+	"green":  ColorGreen,
+	"yellow": ColorYellow,
+	"blue":   ColorBlue,
+	"gray":   ColorGray,
+	"bold":   ColorBold,
+}
+
+// FormatFuncs are the functions available in --format templates. Their last
+// argument is the value, so that they can be used in pipelines, e.g.
+// '{{ .Title | truncate 40 }}'.
+var FormatFuncs = template.FuncMap{
+	"truncate": func(width int, str string) string { return Truncate(str, width) },
+	"pad":      func(width int, str string) string { return Pad(str, width) },
+	"color": func(color string, str string) (string, error) {
+		code, ok := formatColors[color]
+		if !ok {
+			return "", fmt.Errorf("unknown color '%s'", color)
+		}
+		return Colorize(str, code), nil
+	},
+	"ago":   Ago,
+	"join":  func(separator string, items []string) string { return strings.Join(items, separator) },
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// Ago formats the time relative to now, e.g. "3h ago", or "" if it isn't set
+func Ago(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	elapsed := time.Since(t)
+	switch {
+	case elapsed < time.Minute:
+		return "just now"
+	case elapsed < time.Hour:
+		return fmt.Sprintf("%dm ago", int(elapsed.Minutes()))
+	case elapsed < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(elapsed.Hours()))
+	case elapsed < 30*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(elapsed.Hours()/24))
+	case elapsed < 365*24*time.Hour:
+		return fmt.Sprintf("%dmo ago", int(elapsed.Hours()/24/30))
+	default:
+		return fmt.Sprintf("%dy ago", int(elapsed.Hours()/24/365))
+	}
+}
+
+// parseFormatTemplate parses the --format template, looking up named templates
+// in the 'formats' setting
+func parseFormatTemplate() (*template.Template, error) {
+	if outputFormat != OutputTable {
+		return nil, fmt.Errorf("--format can't be used with --output %s", outputFormat)
+	}
+
+	text := formatTemplate
+	if name, ok := strings.CutPrefix(text, "@"); ok {
+		// viper lowercases the keys of maps
+		formats := viper.GetStringMapString(string(FormatsKey))
+		if text, ok = formats[strings.ToLower(name)]; !ok {
+			return nil, fmt.Errorf("no template named '%s' in '%s'", name, FormatsKey)
+		}
+	}
+
+	// allow escaped tabs and newlines, which are hard to type in a shell
+	text = strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(text)
+
+	tmpl, err := template.New("format").Funcs(FormatFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse --format template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// printFormatted prints each item with the --format template, one per line
+func printFormatted[T any](items []T) error {
+	tmpl, err := parseFormatTemplate()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		var builder strings.Builder
+		if err := tmpl.Execute(&builder, item); err != nil {
+			return fmt.Errorf("failed to run --format template: %w", err)
+		}
+		fmt.Fprintln(os.Stdout, builder.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+// fuzzy match scores, from worst to best
+const (
+	FuzzyNoMatch = iota
+	FuzzySubsequenceMatch
+	FuzzySubstringMatch
+	FuzzyWordPrefixMatch
+	FuzzyPrefixMatch
+	FuzzyExactMatch
+)
+
+// FuzzyScore rates how well the query matches the candidate, case-insensitively.
+// An exact match scores highest, followed by a prefix of the whole candidate, a
+// prefix of one of its words, a substring, then the query's characters
+// appearing in order (e.g. 'inprg' matches 'In Progress').
+func FuzzyScore(query string, candidate string) int {
+	query = strings.ToLower(strings.TrimSpace(query))
+	candidate = strings.ToLower(strings.TrimSpace(candidate))
+
+	if query == "" {
+		return FuzzyNoMatch
+	}
+
+	switch {
+	case query == candidate:
+		return FuzzyExactMatch
+	case strings.HasPrefix(candidate, query):
+		return FuzzyPrefixMatch
+	}
+
+	words := strings.FieldsFunc(candidate, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if strings.HasPrefix(word, query) {
+			return FuzzyWordPrefixMatch
+		}
+	}
+
+	if strings.Contains(candidate, query) {
+		return FuzzySubstringMatch
+	}
+
+	// ignore spaces in the query so that 'in prg' still matches 'in progress'
+	remaining := []rune(strings.ReplaceAll(query, " ", ""))
+	for _, r := range candidate {
+		if len(remaining) > 0 && r == remaining[0] {
+			remaining = remaining[1:]
+		}
+	}
+	if len(remaining) == 0 {
+		return FuzzySubsequenceMatch
+	}
+
+	return FuzzyNoMatch
+}
+
+// FuzzyMatch returns the indices of the candidates with the best score for the
+// query, or nothing if no candidate matches
+func FuzzyMatch(query string, candidates []string) []int {
+	bestScore := FuzzyNoMatch
+	matches := []int{}
+
+	for i, candidate := range candidates {
+		score := FuzzyScore(query, candidate)
+		switch {
+		case score == FuzzyNoMatch || score < bestScore:
+			continue
+		case score > bestScore:
+			bestScore = score
+			matches = []int{i}
+		default:
+			matches = append(matches, i)
+		}
+	}
+
+	return matches
+}
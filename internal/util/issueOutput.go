@@ -0,0 +1,14 @@
+package util
+
+import (
+	"github.com/eeternalsadness/jira/pkg/jira"
+)
+
+// IssueColumns are the columns of issue lists
+var IssueColumns = []Column[jira.Issue]{
+	{Header: "ID", Field: "id", Value: func(issue jira.Issue) string { return issue.ID }},
+	{Header: "Key", Field: "key", Value: func(issue jira.Issue) string { return issue.Key }},
+	{Header: "Title", Field: "title", Value: func(issue jira.Issue) string { return issue.Title }},
+	{Header: "Status", Field: "status", Value: func(issue jira.Issue) string { return issue.Status }},
+	{Header: "Status Category", Field: "statusCategory", Value: func(issue jira.Issue) string { return issue.StatusCategory }},
+}
@@ -0,0 +1,234 @@
+package util
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+var issueTemplateExtensions = []string{".yaml", ".yml", ".md"}
+
+// IssueTemplate describes the shape of an issue that gets filed over and over.
+// Summary and Description may contain '{{ .Var }}' placeholders.
+type IssueTemplate struct {
+	Name         string         `yaml:"-"`
+	Path         string         `yaml:"-"`
+	Summary      string         `yaml:"summary"`
+	Project      string         `yaml:"project"`
+	IssueType    string         `yaml:"issue_type"`
+	Labels       []string       `yaml:"labels"`
+	Components   []string       `yaml:"components"`
+	CustomFields map[string]any `yaml:"custom_fields"`
+	Description  string         `yaml:"description"`
+}
+
+// ConfigDir returns the directory that holds the config file
+func ConfigDir() string {
+	if cfgFile := viper.ConfigFileUsed(); cfgFile != "" {
+		return filepath.Dir(cfgFile)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".config", "jira")
+}
+
+// IssueTemplateDirs returns the directories searched for issue templates, from
+// highest to lowest priority: the 'templates_dir' setting, every
+// '.jira/templates/' folder from the current directory up to the root, then the
+// 'templates' folder in the config directory
+func IssueTemplateDirs() []string {
+	dirs := []string{}
+
+	if templatesDir := viper.GetString(string(TemplatesDirKey)); templatesDir != "" {
+		// relative paths in the config file are relative to the config directory,
+		// the repository config file's are already resolved
+		if !filepath.IsAbs(templatesDir) && ConfigValueSource(TemplatesDirKey) == SourceFile {
+			templatesDir = filepath.Join(ConfigDir(), templatesDir)
+		}
+		dirs = append(dirs, templatesDir)
+	}
+
+	if dir, err := os.Getwd(); err == nil {
+		for {
+			dirs = append(dirs, filepath.Join(dir, ".jira", "templates"))
+
+			parent := filepath.Dir(dir)
+			if parent == dir {
+				break
+			}
+			dir = parent
+		}
+	}
+
+	if configDir := ConfigDir(); configDir != "" {
+		dirs = append(dirs, filepath.Join(configDir, "templates"))
+	}
+
+	return dirs
+}
+
+// LoadIssueTemplate finds the template with the given name and parses it
+func LoadIssueTemplate(name string) (IssueTemplate, error) {
+	for _, dir := range IssueTemplateDirs() {
+		for _, ext := range issueTemplateExtensions {
+			templatePath := filepath.Join(dir, name+ext)
+			if _, err := os.Stat(templatePath); err != nil {
+				continue
+			}
+
+			return readIssueTemplate(templatePath)
+		}
+	}
+
+	return IssueTemplate{}, fmt.Errorf("template '%s' not found in any of: %s", name, strings.Join(IssueTemplateDirs(), ", "))
+}
+
+func readIssueTemplate(templatePath string) (IssueTemplate, error) {
+	content, err := os.ReadFile(templatePath)
+	if err != nil {
+		return IssueTemplate{}, fmt.Errorf("failed to read template '%s': %w", templatePath, err)
+	}
+
+	var issueTemplate IssueTemplate
+	if filepath.Ext(templatePath) == ".md" {
+		// markdown templates keep their fields in a front matter block, and the
+		// rest of the file is the description
+		frontMatter, body, err := splitFrontMatter(string(content))
+		if err != nil {
+			return IssueTemplate{}, fmt.Errorf("failed to parse template '%s': %w", templatePath, err)
+		}
+
+		if err := yaml.Unmarshal([]byte(frontMatter), &issueTemplate); err != nil {
+			return IssueTemplate{}, fmt.Errorf("failed to parse template '%s': %w", templatePath, err)
+		}
+		issueTemplate.Description = body
+	} else {
+		if err := yaml.Unmarshal(content, &issueTemplate); err != nil {
+			return IssueTemplate{}, fmt.Errorf("failed to parse template '%s': %w", templatePath, err)
+		}
+	}
+
+	issueTemplate.Name = strings.TrimSuffix(filepath.Base(templatePath), filepath.Ext(templatePath))
+	issueTemplate.Path = templatePath
+
+	return issueTemplate, nil
+}
+
+func splitFrontMatter(content string) (string, string, error) {
+	const delimiter = "---"
+
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.TrimLeft(content, "\n")
+	if !strings.HasPrefix(content, delimiter+"\n") {
+		// no front matter, the whole file is the description
+		return "", content, nil
+	}
+
+	// keep the newline after the opening delimiter, so that an empty front
+	// matter is closed by the next line
+	rest := content[len(delimiter):]
+	end := strings.Index(rest, "\n"+delimiter)
+	if end == -1 {
+		return "", "", errors.New("front matter is not closed with '---'")
+	}
+
+	body := rest[end+len(delimiter)+1:]
+	body = strings.TrimPrefix(body, "\n")
+
+	return rest[:end], strings.TrimSpace(body), nil
+}
+
+// Variables returns the names of the placeholders used in the template's
+// summary and description, in order of first appearance
+func (issueTemplate IssueTemplate) Variables() ([]string, error) {
+	variables := []string{}
+	seen := map[string]bool{}
+
+	for _, text := range []string{issueTemplate.Summary, issueTemplate.Description} {
+		tmpl, err := template.New(issueTemplate.Name).Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template '%s': %w", issueTemplate.Name, err)
+		}
+
+		for _, name := range templateFieldNames(tmpl.Tree.Root) {
+			if !seen[name] {
+				seen[name] = true
+				variables = append(variables, name)
+			}
+		}
+	}
+
+	return variables, nil
+}
+
+func templateFieldNames(node parse.Node) []string {
+	names := []string{}
+
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return names
+		}
+		for _, child := range n.Nodes {
+			names = append(names, templateFieldNames(child)...)
+		}
+	case *parse.ActionNode:
+		names = append(names, templateFieldNames(n.Pipe)...)
+	case *parse.PipeNode:
+		if n == nil {
+			return names
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				names = append(names, templateFieldNames(arg)...)
+			}
+		}
+	case *parse.FieldNode:
+		names = append(names, n.Ident[0])
+	case *parse.IfNode:
+		names = append(names, templateFieldNames(n.Pipe)...)
+		names = append(names, templateFieldNames(n.List)...)
+		names = append(names, templateFieldNames(n.ElseList)...)
+	case *parse.RangeNode:
+		names = append(names, templateFieldNames(n.Pipe)...)
+		names = append(names, templateFieldNames(n.List)...)
+		names = append(names, templateFieldNames(n.ElseList)...)
+	case *parse.WithNode:
+		names = append(names, templateFieldNames(n.Pipe)...)
+		names = append(names, templateFieldNames(n.List)...)
+		names = append(names, templateFieldNames(n.ElseList)...)
+	}
+
+	return names
+}
+
+// Render fills in the template's summary and description with the given variables
+func (issueTemplate IssueTemplate) Render(variables map[string]string) (string, string, error) {
+	rendered := make([]string, 2)
+	for i, text := range []string{issueTemplate.Summary, issueTemplate.Description} {
+		tmpl, err := template.New(issueTemplate.Name).Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to parse template '%s': %w", issueTemplate.Name, err)
+		}
+
+		var buffer bytes.Buffer
+		if err := tmpl.Execute(&buffer, variables); err != nil {
+			return "", "", fmt.Errorf("failed to render template '%s': %w", issueTemplate.Name, err)
+		}
+		rendered[i] = strings.TrimSpace(buffer.String())
+	}
+
+	return rendered[0], rendered[1], nil
+}
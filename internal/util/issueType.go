@@ -0,0 +1,34 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/eeternalsadness/jira/pkg/jira"
+)
+
+// MatchIssueType finds an issue type by its ID, or by its name with fuzzy
+// matching, failing if the name matches none or several issue types
+func MatchIssueType(issueTypes []jira.IssueType, name string) (jira.IssueType, error) {
+	names := make([]string, len(issueTypes))
+	for i, issueType := range issueTypes {
+		if issueType.ID == name {
+			return issueType, nil
+		}
+		names[i] = issueType.Name
+	}
+
+	matches := FuzzyMatch(name, names)
+	switch len(matches) {
+	case 0:
+		return jira.IssueType{}, fmt.Errorf("no issue type matches '%s', available issue types: %s", name, strings.Join(names, ", "))
+	case 1:
+		return issueTypes[matches[0]], nil
+	default:
+		candidates := make([]string, len(matches))
+		for i, match := range matches {
+			candidates[i] = fmt.Sprintf("'%s'", names[match])
+		}
+		return jira.IssueType{}, fmt.Errorf("'%s' is ambiguous, it matches: %s", name, strings.Join(candidates, ", "))
+	}
+}
@@ -0,0 +1,164 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// configMigration upgrades the config file from one version to the next, and
+// returns a description of each change it made
+type configMigration struct {
+	Description string
+	Migrate     func(configFile ConfigFile) []string
+}
+
+// configMigrations upgrade the config file in order, the config file's version
+// being the number of migrations applied to it. A config file without a version
+// predates versioning and is at version 0.
+var configMigrations = []configMigration{
+	{Description: "rename CamelCase and kebab-case keys to snake_case", Migrate: renameLegacyKeys},
+}
+
+// CurrentConfigVersion is the version of config files written by this version
+// of the CLI
+var CurrentConfigVersion = len(configMigrations)
+
+// legacyKeys maps the keys used by older versions to the current keys, or to ""
+// for keys that are no longer used
+var legacyKeys = map[string]ViperKey{
+	// before v0.2.2
+	"Domain":             JiraDomainKey,
+	"Email":              JiraEmailKey,
+	"Token":              JiraTokenKey,
+	"ProjectIDs":         "",
+	"IssueTypeIDs":       "",
+	"DefaultProjectID":   DefaultProjectIDKey,
+	"DefaultIssueTypeID": DefaultIssueTypeIDKey,
+
+	// the lists of projects and issue types were replaced by searchable pickers
+	"project-ids":    "",
+	"issue-type-ids": "",
+}
+
+// configVersion returns the version of the config file
+func (configFile ConfigFile) configVersion() int {
+	version, _ := configFile[string(ConfigVersionKey)].(int)
+	return version
+}
+
+// migrateConfigFile upgrades the config file in use to the current version,
+// keeping a backup of the original file. The file is only rewritten if a
+// migration changed it, since rewriting it drops its comments. If it can't be
+// rewritten, e.g. because it's read-only, the upgrade is applied in memory.
+func migrateConfigFile() (ConfigFile, error) {
+	configFile, err := ReadConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	version := configFile.configVersion()
+	if version > CurrentConfigVersion {
+		fmt.Fprintf(os.Stderr, "Warning: the config file '%s' is at version %d, which is newer than this version of jira supports (%d). Some settings may be ignored.\n", viper.ConfigFileUsed(), version, CurrentConfigVersion)
+		return configFile, nil
+	}
+	if version == CurrentConfigVersion {
+		return configFile, nil
+	}
+
+	var changes []string
+	for _, migration := range configMigrations[version:] {
+		changes = append(changes, migration.Migrate(configFile)...)
+	}
+	// the version is recorded the next time the config file is written
+	if len(changes) == 0 {
+		return configFile, nil
+	}
+	configFile[string(ConfigVersionKey)] = CurrentConfigVersion
+
+	backupFile, err := backupConfigFile(version)
+	if err == nil {
+		err = configFile.Write()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: the config file '%s' is at version %d and can't be upgraded to %d (%s), its settings are upgraded for this run only:\n", viper.ConfigFileUsed(), version, CurrentConfigVersion, err)
+	} else {
+		fmt.Fprintf(os.Stderr, "Upgraded the config file '%s' from version %d to %d, the original is saved as '%s'. Comments in the file were not kept.\n", viper.ConfigFileUsed(), version, CurrentConfigVersion, backupFile)
+	}
+	for _, change := range changes {
+		fmt.Fprintf(os.Stderr, "  - %s\n", change)
+	}
+
+	// load the upgraded settings
+	content, err := yaml.Marshal(map[string]any(configFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the config file: %w", err)
+	}
+	return configFile, viper.ReadConfig(bytes.NewReader(content))
+}
+
+// backupConfigFile copies the config file in use next to it, and returns the
+// path of the copy
+func backupConfigFile(version int) (string, error) {
+	content, err := os.ReadFile(viper.ConfigFileUsed())
+	if err != nil {
+		return "", fmt.Errorf("failed to read the config file '%s': %w", viper.ConfigFileUsed(), err)
+	}
+
+	backupFile := fmt.Sprintf("%s.v%d.bak", viper.ConfigFileUsed(), version)
+	if err := os.WriteFile(backupFile, content, 0o600); err != nil {
+		return "", fmt.Errorf("failed to back up the config file to '%s': %w", backupFile, err)
+	}
+
+	return backupFile, nil
+}
+
+// renameLegacyKeys renames the keys of older versions to the current keys, at the
+// top level and in every profile
+func renameLegacyKeys(configFile ConfigFile) []string {
+	changes := renameKeys(configFile, "")
+
+	profiles, _ := configFile[string(ProfilesKey)].(map[string]any)
+	for name, profile := range profiles {
+		if profileMap, ok := profile.(map[string]any); ok {
+			changes = append(changes, renameKeys(profileMap, fmt.Sprintf("%s.%s.", ProfilesKey, name))...)
+		}
+	}
+	sort.Strings(changes)
+
+	return changes
+}
+
+func renameKeys(settings map[string]any, prefix string) []string {
+	var changes []string
+	for key, value := range settings {
+		newKey, ok := legacyKeys[key]
+		if !ok {
+			// kebab-case keys from v0.2.2
+			snakeKey := strings.ReplaceAll(key, "-", "_")
+			if _, known := LookupConfigKey(snakeKey); !known || snakeKey == key {
+				continue
+			}
+			newKey = ViperKey(snakeKey)
+		}
+
+		delete(settings, key)
+		if newKey == "" {
+			changes = append(changes, fmt.Sprintf("removed '%s%s', which is no longer used", prefix, key))
+			continue
+		}
+		if _, exists := settings[string(newKey)]; exists {
+			changes = append(changes, fmt.Sprintf("removed '%s%s', since '%s%s' is already set", prefix, key, prefix, newKey))
+			continue
+		}
+		settings[string(newKey)] = value
+		changes = append(changes, fmt.Sprintf("renamed '%s%s' to '%s%s'", prefix, key, prefix, newKey))
+	}
+
+	return changes
+}
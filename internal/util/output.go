@@ -0,0 +1,248 @@
+package util
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// output formats picked with the global --output flag
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+	OutputCSV   = "csv"
+	OutputTSV   = "tsv"
+	OutputKeys  = "keys"
+)
+
+// OutputFormats lists the output formats
+var OutputFormats = []string{OutputTable, OutputJSON, OutputYAML, OutputCSV, OutputTSV, OutputKeys}
+
+var outputFormat = OutputTable
+
+// AddOutputFlag adds the global --output flag
+func AddOutputFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", OutputTable, fmt.Sprintf("output format: %s", strings.Join(OutputFormats, ", ")))
+}
+
+// OutputFormat returns the output format picked with --output
+func OutputFormat() string {
+	return outputFormat
+}
+
+// IsTableOutput returns whether the output is meant for people rather than
+// scripts, which it isn't with a --format template
+func IsTableOutput() bool {
+	return outputFormat == OutputTable && formatTemplate == ""
+}
+
+// TableOutputOnlyAnnotation marks commands that only print text for people,
+// which fail with another --output format instead of ignoring it
+const TableOutputOnlyAnnotation = "tableOutputOnly"
+
+// ValidateOutputFormat checks the value of the --output flag, and that the
+// command supports it
+func ValidateOutputFormat(cmd *cobra.Command) error {
+	if !slices.Contains(OutputFormats, outputFormat) {
+		return fmt.Errorf("invalid output format '%s', must be one of: %s", outputFormat, strings.Join(OutputFormats, ", "))
+	}
+
+	if _, ok := cmd.Annotations[TableOutputOnlyAnnotation]; ok && outputFormat != OutputTable {
+		return unsupportedOutputError(OutputTable)
+	}
+
+	return nil
+}
+
+// unsupportedOutputError is returned when the command doesn't support the
+// output format
+func unsupportedOutputError(supported ...string) error {
+	return fmt.Errorf("output format '%s' isn't supported by this command, use one of: %s", outputFormat, strings.Join(supported, ", "))
+}
+
+// Column is a column of tabular output. Header is shown in table output, and
+// Field, which matches the JSON field name, in CSV and TSV output.
+type Column[T any] struct {
+	Header string
+	Field  string
+	Value  func(item T) string
+}
+
+// PrintList prints the items in the output format. Table, CSV, and TSV output
+// show the columns, keys output shows the "key" column (or the first column), and
+// JSON and YAML output encode the items.
+func PrintList[T any](items []T, columns []Column[T]) error {
+	if formatTemplate != "" {
+		return printFormatted(items)
+	}
+	if items == nil {
+		items = []T{}
+	}
+
+	switch outputFormat {
+	case OutputJSON:
+		return printJSON(items)
+	case OutputYAML:
+		return printYAML(items)
+	case OutputCSV:
+		w := csv.NewWriter(os.Stdout)
+		w.Write(columnFields(columns))
+		for _, item := range items {
+			w.Write(columnValues(columns, item))
+		}
+		w.Flush()
+		return w.Error()
+	case OutputTSV:
+		fmt.Println(strings.Join(columnFields(columns), "\t"))
+		for _, item := range items {
+			values := columnValues(columns, item)
+			for i, value := range values {
+				values[i] = strings.NewReplacer("\t", " ", "\n", " ", "\r", "").Replace(value)
+			}
+			fmt.Println(strings.Join(values, "\t"))
+		}
+		return nil
+	case OutputKeys:
+		keyColumn := columns[0]
+		for _, column := range columns {
+			if column.Field == "key" {
+				keyColumn = column
+				break
+			}
+		}
+		for _, item := range items {
+			fmt.Println(keyColumn.Value(item))
+		}
+		return nil
+	default:
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, column := range columns {
+			fmt.Fprintf(w, "%s\t", column.Header)
+		}
+		fmt.Fprintln(w)
+		for _, item := range items {
+			for _, value := range columnValues(columns, item) {
+				fmt.Fprintf(w, "%s\t", value)
+			}
+			fmt.Fprintln(w)
+		}
+		return w.Flush()
+	}
+}
+
+// PrintItem prints a single item in the output format, using printTable for table
+// output
+func PrintItem[T any](item T, columns []Column[T], printTable func() error) error {
+	if formatTemplate != "" {
+		return printFormatted([]T{item})
+	}
+
+	switch outputFormat {
+	case OutputTable:
+		return printTable()
+	case OutputJSON:
+		return printJSON(item)
+	case OutputYAML:
+		return printYAML(item)
+	default:
+		return PrintList([]T{item}, columns)
+	}
+}
+
+// PrintData prints data that doesn't fit in a table, using printTable for table
+// output. Only JSON and YAML output are supported besides tables.
+func PrintData(data any, printTable func() error) error {
+	switch outputFormat {
+	case OutputTable:
+		return printTable()
+	case OutputJSON:
+		return printJSON(data)
+	case OutputYAML:
+		return printYAML(data)
+	default:
+		return unsupportedOutputError(OutputTable, OutputJSON, OutputYAML)
+	}
+}
+
+// PrintDataWithList prints data that doesn't fit in a table, like PrintData, and
+// a list of items from the data for CSV, TSV, keys, and --format output
+func PrintDataWithList[T any](data any, items []T, columns []Column[T], printTable func() error) error {
+	if formatTemplate != "" {
+		return PrintList(items, columns)
+	}
+
+	switch outputFormat {
+	case OutputCSV, OutputTSV, OutputKeys:
+		return PrintList(items, columns)
+	default:
+		return PrintData(data, printTable)
+	}
+}
+
+func columnFields[T any](columns []Column[T]) []string {
+	fields := make([]string, len(columns))
+	for i, column := range columns {
+		fields[i] = column.Field
+	}
+
+	return fields
+}
+
+func columnValues[T any](columns []Column[T], item T) []string {
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		values[i] = column.Value(item)
+	}
+
+	return values
+}
+
+func printJSON(data any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(data)
+}
+
+// printYAML prints the data with the same field names and order as JSON output
+func printYAML(data any) error {
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	// JSON is valid YAML, so decoding it keeps the order of the fields
+	var node yaml.Node
+	if err := yaml.Unmarshal(content, &node); err != nil {
+		return err
+	}
+	resetStyle(&node)
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(buffer.Bytes())
+
+	return err
+}
+
+// resetStyle switches the nodes decoded from JSON to the block style
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
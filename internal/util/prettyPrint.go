@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"unicode/utf8"
 )
 
 func PrettyPrintStructSlice[T any](headerMap map[string]string, structSlice []T) error {
@@ -81,3 +83,71 @@ func PrettyPrintStringSlice(stringSlice []string) error {
 
 	return nil
 }
+
+// ANSI colors used by Colorize
+const (
+	ColorRed    = "\033[31m"
+	ColorGreen  = "\033[32m"
+	ColorYellow = "\033[33m"
+	ColorBlue   = "\033[34m"
+	ColorGray   = "\033[90m"
+	ColorBold   = "\033[1m"
+	colorReset  = "\033[0m"
+)
+
+// IsTerminal returns whether the file is an interactive terminal
+func IsTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Colorize wraps the text in the ANSI color if stdout is a terminal
+func Colorize(text string, color string) string {
+	if !IsTerminal(os.Stdout) || os.Getenv("NO_COLOR") != "" {
+		return text
+	}
+
+	return color + text + colorReset
+}
+
+// TerminalWidth returns the width of the terminal, using $COLUMNS if it's set,
+// and falling back to 80 columns
+func TerminalWidth() int {
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+
+	if width := terminalWidth(); width > 0 {
+		return width
+	}
+
+	return 80
+}
+
+// Truncate shortens the string to at most width characters, ending it with an
+// ellipsis if it's cut off
+func Truncate(str string, width int) string {
+	runes := []rune(str)
+	if len(runes) <= width {
+		return str
+	}
+	if width <= 1 {
+		return string(runes[:max(width, 0)])
+	}
+
+	return string(runes[:width-1]) + "…"
+}
+
+// Pad fills the string with spaces on the right up to width characters
+func Pad(str string, width int) string {
+	length := utf8.RuneCountInString(str)
+	if length >= width {
+		return str
+	}
+
+	return str + strings.Repeat(" ", width-length)
+}
@@ -0,0 +1,314 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultProfile is the profile used when none is selected, and the name given
+// to the settings of a config file without profiles
+const DefaultProfile = "default"
+
+// ProfileEnvVar selects the active profile, overriding the config file
+const ProfileEnvVar = "JIRA_PROFILE"
+
+var activeProfile = DefaultProfile
+
+// ActiveProfile returns the name of the profile in use
+func ActiveProfile() string {
+	return activeProfile
+}
+
+// loadSettings applies the active profile, then the repository config file
+func loadSettings(cmd *cobra.Command, profileFlag string) error {
+	if err := selectProfile(profileFlag); err != nil {
+		// commands that manage the config have to run to fix a missing profile
+		if _, ok := cmd.Annotations[ConfigOptionalAnnotation]; !ok {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", err)
+	}
+
+	return mergeRepoConfig()
+}
+
+// selectProfile picks the active profile, in order of precedence: the --profile
+// flag, the JIRA_PROFILE environment variable, then the 'current_profile' key,
+// and merges the profile's settings over the top-level ones
+func selectProfile(profileFlag string) error {
+	activeProfile = profileFlag
+	if activeProfile == "" {
+		activeProfile = os.Getenv(ProfileEnvVar)
+	}
+	if activeProfile == "" {
+		activeProfile = viper.GetString(string(CurrentProfileKey))
+	}
+	if activeProfile == "" {
+		activeProfile = DefaultProfile
+	}
+
+	profiles := viper.GetStringMap(string(ProfilesKey))
+	if len(profiles) == 0 {
+		// a config file without profiles holds the settings of the default profile
+		if activeProfile != DefaultProfile {
+			return fmt.Errorf("profile '%s' not found, the config file has no profiles", activeProfile)
+		}
+		return nil
+	}
+
+	profile, ok := profiles[activeProfile].(map[string]any)
+	if !ok {
+		return fmt.Errorf("profile '%s' not found, available profiles: %s", activeProfile, strings.Join(ProfileNames(), ", "))
+	}
+
+	return viper.MergeConfigMap(profile)
+}
+
+// ProfileNames returns the names of the profiles in the config file, sorted
+func ProfileNames() []string {
+	profiles := viper.GetStringMap(string(ProfilesKey))
+	if len(profiles) == 0 {
+		return []string{DefaultProfile}
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ConfigFile is the content of the config file, edited as a whole so that
+// values from the environment, flags, or other profiles don't leak into it
+type ConfigFile map[string]any
+
+// ReadConfigFile reads the config file in use, or returns an empty config if it
+// doesn't exist yet
+func ReadConfigFile() (ConfigFile, error) {
+	configFile := ConfigFile{}
+
+	rawContent, err := os.ReadFile(viper.ConfigFileUsed())
+	if errors.Is(err, os.ErrNotExist) {
+		return configFile, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the config file '%s': %w", viper.ConfigFileUsed(), err)
+	}
+
+	// decode into a plain map, since nested maps take the type of the outer map
+	var content map[string]any
+	if err := yaml.Unmarshal(rawContent, &content); err != nil {
+		return nil, fmt.Errorf("failed to parse the config file '%s': %w", viper.ConfigFileUsed(), err)
+	}
+	for key, value := range content {
+		configFile[key] = value
+	}
+
+	return configFile, nil
+}
+
+// Write writes the config back to the config file in use, marking a new config
+// file with the current version
+func (configFile ConfigFile) Write() error {
+	if _, ok := configFile[string(ConfigVersionKey)]; !ok {
+		configFile[string(ConfigVersionKey)] = CurrentConfigVersion
+	}
+
+	content, err := yaml.Marshal(map[string]any(configFile))
+	if err != nil {
+		return fmt.Errorf("failed to encode the config file: %w", err)
+	}
+
+	if err := os.WriteFile(viper.ConfigFileUsed(), content, 0o600); err != nil {
+		return fmt.Errorf("failed to write the config file '%s': %w", viper.ConfigFileUsed(), err)
+	}
+
+	// the config file may hold tokens, so only its owner should read it
+	if err := os.Chmod(viper.ConfigFileUsed(), 0o600); err != nil {
+		return fmt.Errorf("failed to set the permissions of the config file '%s': %w", viper.ConfigFileUsed(), err)
+	}
+
+	return nil
+}
+
+// enforceConfigFilePermissions restricts an existing config file that holds a
+// token to its owner
+func enforceConfigFilePermissions() error {
+	if CheckSecretFilePermissions(viper.ConfigFileUsed()) == nil {
+		return nil
+	}
+
+	configFile, err := ReadConfigFile()
+	if err != nil {
+		return err
+	}
+	if !configFile.hasToken() {
+		return nil
+	}
+
+	if err := os.Chmod(viper.ConfigFileUsed(), 0o600); err != nil {
+		return fmt.Errorf("the config file '%s' holds a token but can be accessed by other users, and its permissions can't be changed: %w", viper.ConfigFileUsed(), err)
+	}
+	fmt.Fprintf(os.Stderr, "Restricted the permissions of '%s' to 0600 since it holds a token.\n", viper.ConfigFileUsed())
+
+	return nil
+}
+
+// hasToken returns whether any profile in the config file holds a token
+func (configFile ConfigFile) hasToken() bool {
+	if _, ok := configFile[string(JiraTokenKey)]; ok {
+		return true
+	}
+
+	profiles, _ := configFile[string(ProfilesKey)].(map[string]any)
+	for _, profile := range profiles {
+		profileMap, _ := profile.(map[string]any)
+		if _, ok := profileMap[string(JiraTokenKey)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasSettings returns whether the config file holds any settings, besides its
+// version
+func (configFile ConfigFile) HasSettings() bool {
+	for key := range configFile {
+		if key != string(ConfigVersionKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasProfiles returns whether the config file keeps its settings in profiles
+func (configFile ConfigFile) HasProfiles() bool {
+	_, ok := configFile[string(ProfilesKey)].(map[string]any)
+	return ok
+}
+
+// Profile returns the settings of a profile, creating the profile if it doesn't
+// exist. A config file without profiles only has the default profile, which is
+// its top level.
+func (configFile ConfigFile) Profile(name string) map[string]any {
+	if !configFile.HasProfiles() {
+		if name == DefaultProfile {
+			return configFile
+		}
+		configFile.convertToProfiles()
+	}
+
+	profiles := configFile[string(ProfilesKey)].(map[string]any)
+	profile, ok := profiles[name].(map[string]any)
+	if !ok {
+		profile = map[string]any{}
+		profiles[name] = profile
+	}
+	if _, ok := configFile[string(CurrentProfileKey)]; !ok {
+		configFile[string(CurrentProfileKey)] = name
+	}
+
+	return profile
+}
+
+// convertToProfiles moves the top-level settings, if any, into the default
+// profile and makes it the current profile
+func (configFile ConfigFile) convertToProfiles() {
+	profiles := map[string]any{}
+	profile := map[string]any{}
+	for key, value := range configFile {
+		if key == string(CurrentProfileKey) || key == string(ConfigVersionKey) {
+			continue
+		}
+		profile[key] = value
+		delete(configFile, key)
+	}
+
+	if len(profile) > 0 {
+		profiles[DefaultProfile] = profile
+		configFile[string(CurrentProfileKey)] = DefaultProfile
+	}
+	configFile[string(ProfilesKey)] = profiles
+}
+
+// SaveProfileValues sets values in the active profile, both in the config file
+// and for the rest of the command
+func SaveProfileValues(values map[ViperKey]any) error {
+	configFile, err := ReadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	profile := configFile.Profile(ActiveProfile())
+	for key, value := range values {
+		profile[string(key)] = value
+		viper.Set(string(key), value)
+	}
+
+	return configFile.Write()
+}
+
+// UnknownKeys returns the keys of the config file that aren't configuration
+// keys, with the profile they're in (e.g. 'profiles.work.foo')
+func (configFile ConfigFile) UnknownKeys() []string {
+	unknown := []string{}
+	for key, value := range configFile {
+		switch key {
+		case string(CurrentProfileKey), string(ConfigVersionKey):
+			continue
+		case string(ProfilesKey):
+			profiles, _ := value.(map[string]any)
+			for name, profile := range profiles {
+				profileMap, _ := profile.(map[string]any)
+				for profileKey := range profileMap {
+					if _, ok := LookupConfigKey(profileKey); !ok {
+						unknown = append(unknown, fmt.Sprintf("%s.%s.%s", ProfilesKey, name, profileKey))
+					}
+				}
+			}
+			continue
+		}
+
+		if _, ok := LookupConfigKey(key); !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+
+	return unknown
+}
+
+// UnsetProfileValue removes a key from the active profile in the config file, and
+// returns whether the key was set
+func UnsetProfileValue(key ViperKey) (bool, error) {
+	configFile, err := ReadConfigFile()
+	if err != nil {
+		return false, err
+	}
+
+	var profile map[string]any
+	if configFile.HasProfiles() {
+		profiles := configFile[string(ProfilesKey)].(map[string]any)
+		profile, _ = profiles[ActiveProfile()].(map[string]any)
+	} else if ActiveProfile() == DefaultProfile {
+		profile = configFile
+	}
+
+	if _, ok := profile[string(key)]; !ok {
+		return false, nil
+	}
+	delete(profile, string(key))
+
+	return true, configFile.Write()
+}
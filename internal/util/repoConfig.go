@@ -0,0 +1,102 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// repoConfigFileNames are the names of repository config files, looked up in
+// every directory from the current directory up to the root
+var repoConfigFileNames = []string{".jira.yaml", filepath.Join(".jira", "config.yaml")}
+
+var (
+	// repoConfigFile is the repository config file in use, if any
+	repoConfigFile string
+	// repoConfigKeys are the keys set by the repository config file
+	repoConfigKeys = map[ViperKey]bool{}
+)
+
+// RepoConfigFileUsed returns the repository config file in use, or "" if there
+// isn't one
+func RepoConfigFileUsed() string {
+	return repoConfigFile
+}
+
+// findRepoConfigFile returns the closest repository config file from the
+// directory upwards, or "" if there isn't one
+func findRepoConfigFile(dir string) string {
+	for {
+		for _, name := range repoConfigFileNames {
+			configPath := filepath.Join(dir, name)
+			if info, err := os.Stat(configPath); err == nil && !info.IsDir() {
+				return configPath
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// mergeRepoConfig merges the settings of the repository config file over the
+// ones of the active profile. Credentials are ignored, since the file is shared
+// with everyone who has access to the repository.
+func mergeRepoConfig() error {
+	repoConfigFile = ""
+	repoConfigKeys = map[ViperKey]bool{}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+	configPath := findRepoConfigFile(dir)
+	if configPath == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the repository config file '%s': %w", configPath, err)
+	}
+
+	var settings map[string]any
+	if err := yaml.Unmarshal(content, &settings); err != nil {
+		return fmt.Errorf("failed to parse the repository config file '%s': %w", configPath, err)
+	}
+
+	var ignored []string
+	for key, value := range settings {
+		configKey, ok := LookupConfigKey(key)
+		if !ok || configKey.Credential {
+			ignored = append(ignored, key)
+			delete(settings, key)
+			continue
+		}
+
+		if err := configKey.Validate(fmt.Sprint(value)); err != nil {
+			return fmt.Errorf("invalid repository config file '%s': %s", configPath, err)
+		}
+
+		// relative paths are relative to the repository config file
+		if configKey.Key == TemplatesDirKey {
+			if dir, ok := value.(string); ok && dir != "" && !filepath.IsAbs(dir) {
+				settings[key] = filepath.Join(filepath.Dir(configPath), dir)
+			}
+		}
+		repoConfigKeys[configKey.Key] = true
+	}
+	if len(ignored) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: ignoring keys of the repository config file '%s' that are unknown or hold credentials: %s\n", configPath, strings.Join(ignored, ", "))
+	}
+
+	repoConfigFile = configPath
+	return viper.MergeConfigMap(settings)
+}
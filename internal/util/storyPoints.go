@@ -0,0 +1,52 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/eeternalsadness/jira/pkg/jira"
+	"github.com/spf13/viper"
+)
+
+// names of the story points field on Jira sites, from most to least common
+var storyPointsFieldNames = []string{"story points", "story point estimate", "story points estimate"}
+
+// StoryPointsField returns the field that holds story points: the configured
+// field if there is one, otherwise the board's estimation field, otherwise a
+// numeric field named like 'Story Points' unless the board counts issues. An
+// empty string means issues should be counted instead.
+func StoryPointsField(jiraClient *jira.Jira, boardID int) string {
+	if field := viper.GetString(string(StoryPointsFieldKey)); field != "" {
+		return field
+	}
+
+	if boardID != 0 {
+		configuration, err := jiraClient.GetBoardConfiguration(boardID)
+		switch {
+		case err != nil:
+		case configuration.EstimationField != "":
+			return configuration.EstimationField
+		case configuration.EstimationType == "issueCount":
+			// the board is estimated by issue count on purpose
+			return ""
+		}
+	}
+
+	return discoverStoryPointsField(jiraClient)
+}
+
+func discoverStoryPointsField(jiraClient *jira.Jira) string {
+	fields, err := jiraClient.GetFields()
+	if err != nil {
+		return ""
+	}
+
+	for _, name := range storyPointsFieldNames {
+		for _, field := range fields {
+			if field.SchemaType == "number" && strings.EqualFold(strings.TrimSpace(field.Name), name) {
+				return field.ID
+			}
+		}
+	}
+
+	return ""
+}
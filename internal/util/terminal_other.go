@@ -0,0 +1,9 @@
+//go:build !unix
+
+package util
+
+// terminalWidth returns the width of the terminal attached to stdout, or 0 if
+// it can't be determined
+func terminalWidth() int {
+	return 0
+}
@@ -0,0 +1,20 @@
+//go:build unix
+
+package util
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// terminalWidth returns the width of the terminal attached to stdout, or 0 if
+// it can't be determined
+func terminalWidth() int {
+	winsize, err := unix.IoctlGetWinsize(int(os.Stdout.Fd()), unix.TIOCGWINSZ)
+	if err != nil {
+		return 0
+	}
+
+	return int(winsize.Col)
+}
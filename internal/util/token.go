@@ -0,0 +1,260 @@
+package util
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// TokenPassphraseEnvVar holds the passphrase of an encrypted token, to avoid
+// being prompted for it
+const TokenPassphraseEnvVar = "JIRA_TOKEN_PASSPHRASE"
+
+const (
+	encryptedTokenPrefix = "encrypted:v1:"
+	pbkdf2Iterations     = 600000
+	saltSize             = 16
+	keySize              = 32
+)
+
+// ResolveToken returns the Jira API token from the first configured source: a
+// token set with a flag or environment variable, the environment variable named
+// by 'token_env', the file at 'token_file', the output of 'token_command', then
+// 'token' from the config file, which may be encrypted
+func ResolveToken() (string, error) {
+	if source := ConfigValueSource(JiraTokenKey); source == SourceFlag || source == SourceEnv {
+		return decryptIfNeeded(viper.GetString(string(JiraTokenKey)))
+	}
+
+	if envVar := viper.GetString(string(JiraTokenEnvKey)); envVar != "" {
+		token := strings.TrimSpace(os.Getenv(envVar))
+		if token == "" {
+			return "", fmt.Errorf("the environment variable %s set in '%s' is empty", envVar, JiraTokenEnvKey)
+		}
+		return token, nil
+	}
+
+	if tokenFile := viper.GetString(string(JiraTokenFileKey)); tokenFile != "" {
+		return readTokenFile(tokenFile)
+	}
+
+	if command := viper.GetString(string(JiraTokenCommandKey)); command != "" {
+		return runTokenCommand(command)
+	}
+
+	return decryptIfNeeded(viper.GetString(string(JiraTokenKey)))
+}
+
+func decryptIfNeeded(token string) (string, error) {
+	if IsEncryptedToken(token) {
+		return decryptToken(token)
+	}
+
+	return token, nil
+}
+
+// HasTokenHelper returns whether the token is read from the environment, a
+// file, or a command rather than stored in the config file
+func HasTokenHelper() bool {
+	return viper.GetString(string(JiraTokenEnvKey)) != "" ||
+		viper.GetString(string(JiraTokenFileKey)) != "" ||
+		viper.GetString(string(JiraTokenCommandKey)) != ""
+}
+
+func readTokenFile(tokenFile string) (string, error) {
+	if strings.HasPrefix(tokenFile, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			tokenFile = filepath.Join(home, tokenFile[2:])
+		}
+	}
+
+	if err := CheckSecretFilePermissions(tokenFile); err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("the token file '%s' is empty", tokenFile)
+	}
+
+	return token, nil
+}
+
+// runTokenCommand runs the command with the shell and uses its output as the
+// token, like a git credential helper
+func runTokenCommand(command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to run '%s': %w", JiraTokenCommandKey, err)
+	}
+
+	// only the first line is the token, like git credential helpers
+	token, _, _ := strings.Cut(stdout.String(), "\n")
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("'%s' didn't print a token", JiraTokenCommandKey)
+	}
+
+	return token, nil
+}
+
+// IsEncryptedToken returns whether the token was encrypted with EncryptToken
+func IsEncryptedToken(token string) bool {
+	return strings.HasPrefix(token, encryptedTokenPrefix)
+}
+
+// CheckSecretFilePermissions makes sure that a file holding a secret can only
+// be read by its owner
+func CheckSecretFilePermissions(path string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to read '%s': %w", path, err)
+	}
+	if info.Mode().Perm()&0o077 != 0 {
+		return fmt.Errorf("'%s' holds a secret but can be accessed by other users (permissions %04o), run 'chmod 600 %s' to fix it", path, info.Mode().Perm(), path)
+	}
+
+	return nil
+}
+
+// EncryptToken encrypts the token with AES-GCM, using a key derived from the
+// passphrase with PBKDF2
+func EncryptToken(token string, passphrase string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	gcm, err := newTokenCipher(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	ciphertext := gcm.Seal(nil, nonce, []byte(token), nil)
+
+	encoding := base64.RawStdEncoding
+	return encryptedTokenPrefix + strings.Join([]string{
+		encoding.EncodeToString(salt),
+		encoding.EncodeToString(nonce),
+		encoding.EncodeToString(ciphertext),
+	}, ":"), nil
+}
+
+// PromptTokenEncryption asks whether to encrypt the token with a passphrase,
+// and returns the token to store in the config file
+func PromptTokenEncryption(token string) (string, error) {
+	encrypt, err := UserYesNo("Encrypt the API token with a passphrase?")
+	if err != nil || !encrypt {
+		return token, err
+	}
+
+	passphrase, err := UserGetSecret("Enter a passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	confirmation, err := UserGetSecret("Enter the passphrase again: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" || passphrase != confirmation {
+		return "", fmt.Errorf("the passphrases are empty or don't match")
+	}
+
+	encryptedToken, err := EncryptToken(token, passphrase)
+	if err != nil {
+		return "", err
+	}
+	fmt.Printf("The token is encrypted. Set %s to avoid being prompted for the passphrase.\n", TokenPassphraseEnvVar)
+
+	return encryptedToken, nil
+}
+
+// decryptToken decrypts an encrypted token with the passphrase from the
+// environment, or prompts for it
+func decryptToken(encryptedToken string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(encryptedToken, encryptedTokenPrefix), ":")
+	if len(parts) != 3 {
+		return "", errors.New("the encrypted token is malformed")
+	}
+
+	encoding := base64.RawStdEncoding
+	decoded := make([][]byte, len(parts))
+	for i, part := range parts {
+		var err error
+		if decoded[i], err = encoding.DecodeString(part); err != nil {
+			return "", errors.New("the encrypted token is malformed")
+		}
+	}
+	salt, nonce, ciphertext := decoded[0], decoded[1], decoded[2]
+
+	passphrase := os.Getenv(TokenPassphraseEnvVar)
+	if passphrase == "" {
+		var err error
+		passphrase, err = UserGetSecret("Enter the passphrase of the Jira API token: ")
+		if err != nil {
+			return "", fmt.Errorf("failed to read the passphrase: %w", err)
+		}
+	}
+
+	gcm, err := newTokenCipher(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return "", errors.New("the encrypted token is malformed")
+	}
+
+	token, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.New("failed to decrypt the token, the passphrase is wrong")
+	}
+
+	return string(token), nil
+}
+
+func newTokenCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(passphrase), salt, pbkdf2Iterations, keySize, sha256.New)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,87 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/eeternalsadness/jira/pkg/jira"
+)
+
+// MissingFieldsError is returned when a transition's required fields are missing
+// and can't be prompted for
+type MissingFieldsError struct {
+	Transition string
+	Fields     []string
+}
+
+func (err *MissingFieldsError) Error() string {
+	return fmt.Sprintf("transition '%s' requires: %s", err.Transition, strings.Join(err.Fields, ", "))
+}
+
+// TransitionFields fills in a transition's screen fields from values keyed by
+// field ID or name. Required fields that are missing are prompted for if
+// isInteractive is set, and cause an error otherwise.
+func TransitionFields(transition jira.Transition, values map[string]string, isInteractive bool) (map[string]any, error) {
+	fields := map[string]any{}
+
+	// fields that were passed in
+	for name, value := range values {
+		field, ok := transition.FieldByName(name)
+		if !ok {
+			return nil, fmt.Errorf("transition '%s' has no field '%s' on its screen", transition.Name, name)
+		}
+
+		formattedValue, err := field.FormatValue(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[field.ID] = formattedValue
+	}
+
+	// required fields that are still missing
+	var missingFields []string
+	for _, field := range transition.RequiredFields() {
+		if _, ok := fields[field.ID]; ok {
+			continue
+		}
+
+		if !isInteractive {
+			missingFields = append(missingFields, field.Name)
+			continue
+		}
+
+		formattedValue, err := promptTransitionField(transition, field)
+		if err != nil {
+			return nil, err
+		}
+		fields[field.ID] = formattedValue
+	}
+
+	if len(missingFields) > 0 {
+		return nil, &MissingFieldsError{Transition: transition.Name, Fields: missingFields}
+	}
+
+	return fields, nil
+}
+
+func promptTransitionField(transition jira.Transition, field jira.TransitionField) (any, error) {
+	if len(field.AllowedValues) == 0 {
+		value, err := UserGetString(fmt.Sprintf("Transition '%s' requires '%s': ", transition.Name, field.Name), nil, false)
+		if err != nil {
+			return nil, err
+		}
+		return field.FormatValue(*value)
+	}
+
+	fmt.Printf("Transition '%s' requires '%s':\n", transition.Name, field.Name)
+	if err := PrettyPrintStringSlice(field.AllowedValues); err != nil {
+		return nil, err
+	}
+
+	index, err := UserSelectFromRange(len(field.AllowedValues))
+	if err != nil {
+		return nil, err
+	}
+
+	return field.FormatValue(field.AllowedValues[index])
+}
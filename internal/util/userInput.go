@@ -6,12 +6,20 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/term"
 )
 
 var ErrUserQuit = errors.New("")
 
+// stdinReader is shared between prompts so that input buffered by one prompt
+// isn't lost to the next, e.g. when input is piped in
+var stdinReader = bufio.NewReader(os.Stdin)
+
 // NOTE: this is basically UserGetBool(), but standardized
 func UserYesNo(prompt string) (bool, error) {
 	userInput, err := UserGetString(
@@ -74,8 +82,7 @@ func UserGetInt(prompt string, defaultVal *int, hasQuitOption bool) (*int, error
 func UserGetString(prompt string, defaultVal *string, hasQuitOption bool) (*string, error) {
 	fmt.Print(prompt)
 
-	reader := bufio.NewReader(os.Stdin)
-	userInput, err := reader.ReadString('\n')
+	userInput, err := stdinReader.ReadString('\n')
 	if err != nil && errors.Is(err, io.EOF) {
 		return nil, err
 	}
@@ -92,3 +99,122 @@ func UserGetString(prompt string, defaultVal *string, hasQuitOption bool) (*stri
 
 	return &userInput, nil
 }
+
+// UserSearchSelect lets the user narrow down the options with a fuzzy search,
+// then pick one of the matches. Options and the header are rows of
+// tab-separated columns. It returns the index of the selected option.
+func UserSearchSelect(header string, options []string) (int, error) {
+	const displayLimit = 20
+
+	if len(options) == 0 {
+		panic("options must not be empty!")
+	}
+
+	for {
+		query, err := UserGetString("\nSearch (leave empty to list all, or 'q' to quit): ", nil, true)
+		if err != nil {
+			return -1, err
+		}
+
+		matches := searchOptions(*query, options)
+		if len(matches) == 0 {
+			fmt.Println("No matches found.")
+			continue
+		}
+
+		// print out matches
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "#\t%s\t\n", header)
+		for i, match := range matches[:min(len(matches), displayLimit)] {
+			fmt.Fprintf(w, "%d\t%s\t\n", i+1, options[match])
+		}
+		w.Flush()
+		if len(matches) > displayLimit {
+			fmt.Printf("... and %d more, refine your search to see them\n", len(matches)-displayLimit)
+			matches = matches[:displayLimit]
+		}
+
+		// re-prompt on an invalid selection, keeping the matches
+		for {
+			selection, err := UserGetString(
+				fmt.Sprintf("\nSelect an option [1 - %d], leave empty to search again, or 'q' to quit: ", len(matches)),
+				nil,
+				true)
+			if err != nil {
+				return -1, err
+			}
+			if *selection == "" {
+				break
+			}
+
+			index, err := strconv.Atoi(*selection)
+			if err != nil || index < 1 || index > len(matches) {
+				fmt.Printf("You must choose a number between 1 and %d (inclusive).\n", len(matches))
+				continue
+			}
+
+			return matches[index-1], nil
+		}
+	}
+}
+
+// searchOptions returns the indices of the options matching the query, best
+// matches first, or all options if the query is empty
+func searchOptions(query string, options []string) []int {
+	matches := []int{}
+	scores := map[int]int{}
+	for i, option := range options {
+		if strings.TrimSpace(query) == "" {
+			matches = append(matches, i)
+			continue
+		}
+
+		// score each column separately so that e.g. a key prefix is a prefix match
+		for _, column := range strings.Split(option, "\t") {
+			scores[i] = max(scores[i], FuzzyScore(query, column))
+		}
+		if scores[i] != FuzzyNoMatch {
+			matches = append(matches, i)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return scores[matches[i]] > scores[matches[j]]
+	})
+
+	return matches
+}
+
+// UserGetSecret prompts for a value without echoing it to the terminal
+func UserGetSecret(prompt string) (string, error) {
+	fmt.Print(prompt)
+	secret, err := readPassword()
+	fmt.Println()
+
+	return secret, err
+}
+
+// readPassword reads a line from stdin without echoing it if stdin is a
+// terminal
+func readPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return readLine()
+	}
+
+	password, err := term.ReadPassword(fd)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(password)), nil
+}
+
+func readLine() (string, error) {
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
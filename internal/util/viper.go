@@ -2,7 +2,10 @@ package util
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+
+	"github.com/spf13/viper"
 )
 
 type ViperKey string
@@ -12,10 +15,172 @@ const (
 	JiraEmailKey  ViperKey = "email"
 	JiraTokenKey  ViperKey = "token"
 
+	// alternatives to storing the token in the config file
+	JiraTokenCommandKey ViperKey = "token_command"
+	JiraTokenFileKey    ViperKey = "token_file"
+	JiraTokenEnvKey     ViperKey = "token_env"
+
 	DefaultProjectIDKey   ViperKey = "default_project_id"
 	DefaultIssueTypeIDKey ViperKey = "default_issue_type_id"
+	DefaultBoardIDKey     ViperKey = "default_board_id"
+
+	// human-readable names stored alongside the default IDs, for display only
+	DefaultProjectKeyKey    ViperKey = "default_project_key"
+	DefaultIssueTypeNameKey ViperKey = "default_issue_type_name"
+
+	StoryPointsFieldKey ViperKey = "story_points_field"
+
+	// defaults for new issues and branches, usually set per repository
+	DefaultLabelsKey     ViperKey = "default_labels"
+	DefaultComponentsKey ViperKey = "default_components"
+	TemplatesDirKey      ViperKey = "templates_dir"
+	BranchTemplateKey    ViperKey = "branch_template"
+
+	// named --format templates
+	FormatsKey ViperKey = "formats"
+
+	// top-level keys holding the named profiles and the profile in use
+	CurrentProfileKey ViperKey = "current_profile"
+	ProfilesKey       ViperKey = "profiles"
+
+	// top-level key holding the version of the config file format
+	ConfigVersionKey ViperKey = "config_version"
 )
 
+// KeyType is the type of the values a configuration key accepts
+type KeyType string
+
+const (
+	KeyTypeString KeyType = "string"
+	KeyTypeID     KeyType = "id"
+	// a YAML list, or a comma-separated string
+	KeyTypeList KeyType = "list"
+	// a YAML map, which can only be set in the config file
+	KeyTypeMap KeyType = "map"
+)
+
+// ConfigKey describes a key of a profile's configuration, which can also be set
+// with an environment variable or a global flag
+type ConfigKey struct {
+	Key    ViperKey
+	Type   KeyType
+	Secret bool
+	// credentials can't be set in a repository config file
+	Credential  bool
+	Description string
+	// environment variables that set the key, in order of precedence
+	EnvVars []string
+}
+
+// ConfigKeys lists every key that can be set in a profile
+var ConfigKeys = []ConfigKey{
+	{Key: JiraDomainKey, Type: KeyTypeString, Credential: true, Description: "Jira domain, e.g. example.atlassian.net"},
+	{Key: JiraEmailKey, Type: KeyTypeString, Credential: true, Description: "email address used for Jira"},
+	{Key: JiraTokenKey, Type: KeyTypeString, Secret: true, Credential: true, Description: "Jira API token", EnvVars: []string{"JIRA_API_TOKEN", "JIRA_TOKEN"}},
+	{Key: JiraTokenCommandKey, Type: KeyTypeString, Credential: true, Description: "command that prints the Jira API token"},
+	{Key: JiraTokenFileKey, Type: KeyTypeString, Credential: true, Description: "file that holds the Jira API token"},
+	{Key: JiraTokenEnvKey, Type: KeyTypeString, Credential: true, Description: "environment variable that holds the Jira API token"},
+	{Key: DefaultProjectIDKey, Type: KeyTypeID, Description: "ID of the default project"},
+	{Key: DefaultProjectKeyKey, Type: KeyTypeString, Description: "key of the default project, for display"},
+	{Key: DefaultIssueTypeIDKey, Type: KeyTypeID, Description: "ID of the default issue type"},
+	{Key: DefaultIssueTypeNameKey, Type: KeyTypeString, Description: "name of the default issue type, for display"},
+	{Key: DefaultBoardIDKey, Type: KeyTypeID, Description: "ID of the default board"},
+	{Key: StoryPointsFieldKey, Type: KeyTypeString, Description: "field that holds story points, e.g. customfield_10016"},
+	{Key: DefaultLabelsKey, Type: KeyTypeList, Description: "labels added to new issues, comma-separated"},
+	{Key: DefaultComponentsKey, Type: KeyTypeList, Description: "components added to new issues, comma-separated"},
+	{Key: TemplatesDirKey, Type: KeyTypeString, Description: "directory searched first for issue templates"},
+	{Key: BranchTemplateKey, Type: KeyTypeString, Description: "Go template for branch names, e.g. '{{ .Key }}-{{ .Slug }}'"},
+	{Key: FormatsKey, Type: KeyTypeMap, Description: "named --format templates, used with --format @NAME"},
+}
+
+// LookupConfigKey returns the configuration key with the given name
+func LookupConfigKey(name string) (ConfigKey, bool) {
+	for _, configKey := range ConfigKeys {
+		if string(configKey.Key) == name {
+			return configKey, true
+		}
+	}
+
+	return ConfigKey{}, false
+}
+
+// ConfigKeyNames returns the names of the configuration keys
+func ConfigKeyNames() []string {
+	names := make([]string, len(ConfigKeys))
+	for i, configKey := range ConfigKeys {
+		names[i] = string(configKey.Key)
+	}
+
+	return names
+}
+
+// Env returns the environment variables that set the key, JIRA_ followed by
+// the key in upper case unless set otherwise
+func (configKey ConfigKey) Env() []string {
+	if len(configKey.EnvVars) > 0 {
+		return configKey.EnvVars
+	}
+
+	return []string{"JIRA_" + strings.ToUpper(string(configKey.Key))}
+}
+
+// FlagName returns the name of the global flag that sets the key
+func (configKey ConfigKey) FlagName() string {
+	return strings.ReplaceAll(string(configKey.Key), "_", "-")
+}
+
+// Validate checks that the value has the key's type
+func (configKey ConfigKey) Validate(value string) error {
+	switch configKey.Type {
+	case KeyTypeID:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("'%s' must be a numeric ID, got '%s'", configKey.Key, value)
+		}
+	}
+
+	return nil
+}
+
+// Parse converts a value passed in as a string to the value stored in the config
+// file, splitting lists on commas
+func (configKey ConfigKey) Parse(value string) any {
+	if configKey.Type == KeyTypeList {
+		return splitList(value)
+	}
+
+	return value
+}
+
+// GetStringList returns the value of a list key, which is either a list or a
+// comma-separated string (e.g. from an environment variable)
+func GetStringList(key ViperKey) []string {
+	switch value := viper.Get(string(key)).(type) {
+	case nil:
+		return nil
+	case []any:
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			items = append(items, strings.TrimSpace(fmt.Sprint(item)))
+		}
+		return items
+	case []string:
+		return value
+	default:
+		return splitList(fmt.Sprint(value))
+	}
+}
+
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
 func SensorString(str string) string {
 	// otherwise show the first and last 25% chars (max 4)
 	charsToShow := len(str) / 4
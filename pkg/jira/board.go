@@ -0,0 +1,252 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// NOTE: follow Jira API reference
+type Board struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	ProjectKey string `json:"projectKey"`
+}
+
+type BoardConfiguration struct {
+	ID               int           `json:"id"`
+	Name             string        `json:"name"`
+	FilterID         string        `json:"filterId"`
+	ColumnConstraint string        `json:"columnConstraint"`
+	Columns          []BoardColumn `json:"columns"`
+	EstimationType   string        `json:"estimationType"`
+	EstimationField  string        `json:"estimationField"`
+}
+
+// BoardColumn is a column of a board, with the statuses mapped to it and its
+// WIP limits (0 when not set)
+type BoardColumn struct {
+	Name      string   `json:"name"`
+	StatusIDs []string `json:"statusIds"`
+	Min       int      `json:"min"`
+	Max       int      `json:"max"`
+}
+
+// GetBoards returns all boards, optionally filtered by project and board type
+// (scrum or kanban), following pagination
+func (jira *Jira) GetBoards(projectKeyOrID string, boardType string) ([]Board, error) {
+	outBoards := []Board{}
+
+	for startAt := 0; ; {
+		// call api
+		query := url.Values{}
+		query.Set("startAt", strconv.Itoa(startAt))
+		query.Set("maxResults", "50")
+		if projectKeyOrID != "" {
+			query.Set("projectKeyOrId", projectKeyOrID)
+		}
+		if boardType != "" {
+			query.Set("type", boardType)
+		}
+		path := fmt.Sprintf("rest/agile/1.0/board?%s", query.Encode())
+		resp, err := jira.callAPI(path, "GET", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// parse json
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+		}
+
+		// transform json into output
+		values := data["values"].([]any)
+		for _, board := range values {
+			outBoards = append(outBoards, parseBoard(board.(map[string]any)))
+		}
+
+		// stop at the last page
+		if isLast, _ := data["isLast"].(bool); isLast || len(values) == 0 {
+			break
+		}
+		startAt += len(values)
+	}
+
+	return outBoards, nil
+}
+
+func (jira *Jira) GetBoard(boardID int) (Board, error) {
+	// call api
+	path := fmt.Sprintf("rest/agile/1.0/board/%d", boardID)
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return Board{}, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data map[string]any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return Board{}, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	return parseBoard(data), nil
+}
+
+func parseBoard(boardMap map[string]any) Board {
+	outBoard := Board{
+		ID:   int(boardMap["id"].(float64)),
+		Name: boardMap["name"].(string),
+		Type: boardMap["type"].(string),
+	}
+	if locationMap, ok := boardMap["location"].(map[string]any); ok {
+		outBoard.ProjectKey, _ = locationMap["projectKey"].(string)
+	}
+
+	return outBoard
+}
+
+func (jira *Jira) GetBoardConfiguration(boardID int) (BoardConfiguration, error) {
+	// call api
+	path := fmt.Sprintf("rest/agile/1.0/board/%d/configuration", boardID)
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return BoardConfiguration{}, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data map[string]any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return BoardConfiguration{}, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	// transform json into output
+	outConfiguration := BoardConfiguration{
+		ID:   int(data["id"].(float64)),
+		Name: data["name"].(string),
+	}
+	if filterMap, ok := data["filter"].(map[string]any); ok {
+		outConfiguration.FilterID, _ = filterMap["id"].(string)
+	}
+	if estimationMap, ok := data["estimation"].(map[string]any); ok {
+		outConfiguration.EstimationType, _ = estimationMap["type"].(string)
+		if fieldMap, ok := estimationMap["field"].(map[string]any); ok {
+			outConfiguration.EstimationField, _ = fieldMap["fieldId"].(string)
+		}
+	}
+
+	columnConfigMap := data["columnConfig"].(map[string]any)
+	outConfiguration.ColumnConstraint, _ = columnConfigMap["constraintType"].(string)
+	for _, column := range columnConfigMap["columns"].([]any) {
+		columnMap := column.(map[string]any)
+		outColumn := BoardColumn{Name: columnMap["name"].(string)}
+
+		statuses, _ := columnMap["statuses"].([]any)
+		for _, status := range statuses {
+			outColumn.StatusIDs = append(outColumn.StatusIDs, status.(map[string]any)["id"].(string))
+		}
+		if min, ok := columnMap["min"].(float64); ok {
+			outColumn.Min = int(min)
+		}
+		if max, ok := columnMap["max"].(float64); ok {
+			outColumn.Max = int(max)
+		}
+
+		outConfiguration.Columns = append(outConfiguration.Columns, outColumn)
+	}
+
+	return outConfiguration, nil
+}
+
+// GetBoardIssues returns all issues on a board, based on the board's filter and
+// narrowed down by an optional JQL query, following pagination
+func (jira *Jira) GetBoardIssues(boardID int, jql string) ([]Issue, error) {
+	query := url.Values{}
+	query.Set("fields", "summary,status,issuetype,assignee,priority")
+	if jql != "" {
+		query.Set("jql", jql)
+	}
+
+	path := fmt.Sprintf("rest/agile/1.0/board/%d/issue", boardID)
+	return jira.getAgileIssues(path, query, "")
+}
+
+// FindBoard returns a board by its ID, or by its name (case-insensitive)
+func (jira *Jira) FindBoard(boardNameOrID string) (Board, error) {
+	if boardID, err := strconv.Atoi(boardNameOrID); err == nil {
+		return jira.GetBoard(boardID)
+	}
+
+	boards, err := jira.GetBoards("", "")
+	if err != nil {
+		return Board{}, err
+	}
+
+	var matches []Board
+	for _, board := range boards {
+		if strings.EqualFold(board.Name, boardNameOrID) {
+			matches = append(matches, board)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return Board{}, fmt.Errorf("no board named '%s'", boardNameOrID)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, board := range matches {
+			ids[i] = strconv.Itoa(board.ID)
+		}
+		return Board{}, fmt.Errorf("several boards are named '%s', use one of the board IDs instead: %s", boardNameOrID, strings.Join(ids, ", "))
+	}
+}
+
+// getAgileIssues pages through an agile API endpoint that returns issues
+func (jira *Jira) getAgileIssues(path string, query url.Values, storyPointsField string) ([]Issue, error) {
+	outIssues := []Issue{}
+
+	if query.Get("fields") == "" {
+		query.Set("fields", "summary,status")
+	}
+	for startAt := 0; ; {
+		// call api
+		query.Set("startAt", strconv.Itoa(startAt))
+		query.Set("maxResults", "100")
+		resp, err := jira.callAPI(fmt.Sprintf("%s?%s", path, query.Encode()), "GET", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// parse json
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+		}
+
+		// transform json into output
+		issues := data["issues"].([]any)
+		for _, issue := range issues {
+			outIssue := parseIssue(issue.(map[string]any))
+			outIssue.StoryPoints = parseStoryPoints(issue.(map[string]any), storyPointsField)
+			outIssues = append(outIssues, outIssue)
+		}
+
+		// stop at the last page
+		total, _ := data["total"].(float64)
+		startAt += len(issues)
+		if len(issues) == 0 || startAt >= int(total) {
+			break
+		}
+	}
+
+	return outIssues, nil
+}
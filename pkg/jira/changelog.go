@@ -0,0 +1,151 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// NOTE: follow Jira API reference
+type ChangelogEntry struct {
+	Created time.Time       `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// ChangelogItem is a change to a single field. From and To hold IDs (e.g. status
+// or sprint IDs) while FromString and ToString hold display values.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldID    string `json:"fieldId"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// SearchIssuesWithChangelog returns all issues matching the JQL query along with
+// their full changelog, following pagination. If storyPointsField is set, the
+// issues' story points are read from that field.
+func (jira *Jira) SearchIssuesWithChangelog(jql string, storyPointsField string) ([]Issue, error) {
+	outIssues := []Issue{}
+	nextPageToken := ""
+
+	fields := "summary,status,issuetype,assignee,created"
+	if storyPointsField != "" {
+		fields += "," + storyPointsField
+	}
+
+	for {
+		// call api
+		query := url.Values{}
+		query.Set("jql", jql)
+		query.Set("fields", fields)
+		query.Set("expand", "changelog")
+		query.Set("maxResults", "100")
+		if nextPageToken != "" {
+			query.Set("nextPageToken", nextPageToken)
+		}
+		path := fmt.Sprintf("rest/api/3/search/jql?%s", query.Encode())
+		resp, err := jira.callAPI(path, "GET", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// parse json data
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+		}
+
+		// transform json into output
+		for _, issue := range data["issues"].([]any) {
+			issueMap := issue.(map[string]any)
+			outIssue := parseIssue(issueMap)
+			outIssue.StoryPoints = parseStoryPoints(issueMap, storyPointsField)
+
+			changelogMap, _ := issueMap["changelog"].(map[string]any)
+			histories, _ := changelogMap["histories"].([]any)
+			total, _ := changelogMap["total"].(float64)
+			if int(total) > len(histories) {
+				// the changelog embedded in search results is truncated
+				outIssue.Changelog, err = jira.GetIssueChangelog(outIssue.Key)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				outIssue.Changelog = parseChangelogEntries(histories)
+			}
+
+			outIssues = append(outIssues, outIssue)
+		}
+
+		// stop at the last page
+		token, ok := data["nextPageToken"].(string)
+		if isLast, _ := data["isLast"].(bool); isLast || !ok || token == "" {
+			break
+		}
+		nextPageToken = token
+	}
+
+	return outIssues, nil
+}
+
+// GetIssueChangelog returns the full changelog of an issue, oldest first,
+// following pagination
+func (jira *Jira) GetIssueChangelog(issueID string) ([]ChangelogEntry, error) {
+	outEntries := []ChangelogEntry{}
+
+	for startAt := 0; ; {
+		// call api
+		path := fmt.Sprintf("rest/api/3/issue/%s/changelog?startAt=%s&maxResults=100", issueID, strconv.Itoa(startAt))
+		resp, err := jira.callAPI(path, "GET", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// parse json
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+		}
+
+		// transform json into output
+		values := data["values"].([]any)
+		outEntries = append(outEntries, parseChangelogEntries(values)...)
+
+		// stop at the last page
+		if isLast, _ := data["isLast"].(bool); isLast || len(values) == 0 {
+			break
+		}
+		startAt += len(values)
+	}
+
+	return outEntries, nil
+}
+
+func parseChangelogEntries(histories []any) []ChangelogEntry {
+	outEntries := make([]ChangelogEntry, len(histories))
+	for i, history := range histories {
+		historyMap := history.(map[string]any)
+		outEntries[i].Created = parseTime(historyMap["created"])
+
+		items, _ := historyMap["items"].([]any)
+		for _, item := range items {
+			itemMap := item.(map[string]any)
+			outItem := ChangelogItem{}
+			outItem.Field, _ = itemMap["field"].(string)
+			outItem.FieldID, _ = itemMap["fieldId"].(string)
+			outItem.From, _ = itemMap["from"].(string)
+			outItem.FromString, _ = itemMap["fromString"].(string)
+			outItem.To, _ = itemMap["to"].(string)
+			outItem.ToString, _ = itemMap["toString"].(string)
+			outEntries[i].Items = append(outEntries[i].Items, outItem)
+		}
+	}
+
+	return outEntries
+}
@@ -0,0 +1,47 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NOTE: follow Jira API reference
+type Field struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Custom     bool   `json:"custom"`
+	SchemaType string `json:"schemaType"`
+}
+
+// GetFields returns all system and custom fields
+func (jira *Jira) GetFields() ([]Field, error) {
+	// call api
+	path := "rest/api/3/field"
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data []any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	// transform json into output
+	outFields := make([]Field, len(data))
+	for i, field := range data {
+		fieldMap := field.(map[string]any)
+		outFields[i] = Field{
+			ID:   fieldMap["id"].(string),
+			Name: fieldMap["name"].(string),
+		}
+		outFields[i].Custom, _ = fieldMap["custom"].(bool)
+		if schemaMap, ok := fieldMap["schema"].(map[string]any); ok {
+			outFields[i].SchemaType, _ = schemaMap["type"].(string)
+		}
+	}
+
+	return outFields, nil
+}
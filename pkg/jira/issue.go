@@ -5,69 +5,146 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // NOTE: follow Jira API reference
 type Issue struct {
-	ID             string
-	Key            string
-	Title          string
-	Description    string
-	Status         string
-	StatusCategory string
-	URL            string
+	ID                string           `json:"id"`
+	Key               string           `json:"key"`
+	Title             string           `json:"title"`
+	Description       string           `json:"description"`
+	Status            string           `json:"status"`
+	StatusID          string           `json:"statusId"`
+	StatusCategory    string           `json:"statusCategory"`
+	StatusCategoryKey string           `json:"statusCategoryKey"`
+	IssueType         string           `json:"issueType"`
+	IssueTypeID       string           `json:"issueTypeId"`
+	IsSubtask         bool             `json:"isSubtask"`
+	ProjectID         string           `json:"projectId"`
+	ProjectKey        string           `json:"projectKey"`
+	Assignee          string           `json:"assignee"`
+	Priority          string           `json:"priority"`
+	StoryPoints       float64          `json:"storyPoints"`
+	Created           time.Time        `json:"created"`
+	Updated           time.Time        `json:"updated"`
+	URL               string           `json:"url"`
+	Changelog         []ChangelogEntry `json:"changelog,omitempty"`
 }
 
+// issueFields are the fields requested for the Issue struct
+const issueFields = "summary,status,issuetype,project,assignee,priority,created,updated"
+
 func (jira *Jira) GetAssignedIssues() ([]Issue, error) {
-	// call api
-	jql := url.QueryEscape("assignee = currentuser() AND statuscategory != \"Done\"")
-	fields := url.QueryEscape("summary,status")
-	path := fmt.Sprintf("rest/api/3/search/jql?jql=%s&fields=%s", jql, fields)
-	resp, err := jira.callAPI(path, "GET", nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to call Jira API: %w", err)
-	}
+	return jira.SearchIssues("assignee = currentuser() AND statuscategory != \"Done\"")
+}
 
-	// parse json data
-	var data map[string]any
-	err = json.Unmarshal(resp, &data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
-	}
+// SearchIssues returns all issues matching the JQL query, following pagination
+func (jira *Jira) SearchIssues(jql string) ([]Issue, error) {
+	outIssues := []Issue{}
+	nextPageToken := ""
 
-	// transform json into output
-	issues := data["issues"].([]any)
-	outIssues := make([]Issue, len(issues))
-	for i, issue := range issues {
-		issueMap := issue.(map[string]any)
-		fieldsMap := issueMap["fields"].(map[string]any)
-		statusMap := fieldsMap["status"].(map[string]any)
-		statusCategoryMap := statusMap["statusCategory"].(map[string]any)
-
-		// get the necessary fields for the struct
-		id := issueMap["id"].(string)
-		key := issueMap["key"].(string)
-		title := fieldsMap["summary"].(string)
-		status := statusMap["name"].(string)
-		statusCategory := statusCategoryMap["name"].(string)
-		url := issueMap["self"].(string)
-		outIssues[i] = Issue{
-			ID:             id,
-			Key:            key,
-			Title:          title,
-			Description:    "",
-			Status:         status,
-			StatusCategory: statusCategory,
-			URL:            url,
+	for {
+		// call api
+		query := url.Values{}
+		query.Set("jql", jql)
+		query.Set("fields", issueFields)
+		query.Set("maxResults", "100")
+		if nextPageToken != "" {
+			query.Set("nextPageToken", nextPageToken)
+		}
+		path := fmt.Sprintf("rest/api/3/search/jql?%s", query.Encode())
+		resp, err := jira.callAPI(path, "GET", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// parse json data
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
 		}
+
+		// transform json into output
+		for _, issue := range data["issues"].([]any) {
+			outIssues = append(outIssues, parseIssue(issue.(map[string]any)))
+		}
+
+		// stop at the last page
+		token, ok := data["nextPageToken"].(string)
+		if isLast, _ := data["isLast"].(bool); isLast || !ok || token == "" {
+			break
+		}
+		nextPageToken = token
 	}
 
 	return outIssues, nil
 }
 
+// parseIssue transforms an issue object from the Jira API into an Issue
+func parseIssue(issueMap map[string]any) Issue {
+	fieldsMap := issueMap["fields"].(map[string]any)
+	statusMap := fieldsMap["status"].(map[string]any)
+	statusCategoryMap := statusMap["statusCategory"].(map[string]any)
+
+	// get the necessary fields for the struct
+	description := ""
+	if descriptionMap, ok := fieldsMap["description"].(map[string]any); ok {
+		description = getIssueDescriptionText(descriptionMap)
+	}
+
+	outIssue := Issue{
+		ID:                issueMap["id"].(string),
+		Key:               issueMap["key"].(string),
+		Title:             fieldsMap["summary"].(string),
+		Description:       description,
+		Status:            statusMap["name"].(string),
+		StatusID:          statusMap["id"].(string),
+		StatusCategory:    statusCategoryMap["name"].(string),
+		StatusCategoryKey: statusCategoryMap["key"].(string),
+		URL:               issueMap["self"].(string),
+	}
+
+	// optional fields that are only returned when requested
+	if issueTypeMap, ok := fieldsMap["issuetype"].(map[string]any); ok {
+		outIssue.IssueType = issueTypeMap["name"].(string)
+		outIssue.IssueTypeID = issueTypeMap["id"].(string)
+		outIssue.IsSubtask, _ = issueTypeMap["subtask"].(bool)
+	}
+	if projectMap, ok := fieldsMap["project"].(map[string]any); ok {
+		outIssue.ProjectID = projectMap["id"].(string)
+		outIssue.ProjectKey = projectMap["key"].(string)
+	}
+	if assigneeMap, ok := fieldsMap["assignee"].(map[string]any); ok {
+		outIssue.Assignee, _ = assigneeMap["displayName"].(string)
+	}
+	outIssue.Created = parseTime(fieldsMap["created"])
+	outIssue.Updated = parseTime(fieldsMap["updated"])
+	if priorityMap, ok := fieldsMap["priority"].(map[string]any); ok {
+		outIssue.Priority, _ = priorityMap["name"].(string)
+	}
+
+	return outIssue
+}
+
+// parseStoryPoints reads an issue's story points from the estimation field, which
+// is a custom field that differs between Jira sites
+func parseStoryPoints(issueMap map[string]any, storyPointsField string) float64 {
+	if storyPointsField == "" {
+		return 0
+	}
+
+	fieldsMap := issueMap["fields"].(map[string]any)
+	storyPoints, _ := fieldsMap[storyPointsField].(float64)
+
+	return storyPoints
+}
+
 func (jira *Jira) GetIssueByID(issueID string) (Issue, error) {
-	fields := url.QueryEscape("summary,description,comment,status")
+	fields := url.QueryEscape(issueFields + ",description,comment")
 	path := fmt.Sprintf("rest/api/3/issue/%s?fields=%s", issueID, fields)
 	resp, err := jira.callAPI(path, "GET", nil)
 	if err != nil {
@@ -81,38 +158,8 @@ func (jira *Jira) GetIssueByID(issueID string) (Issue, error) {
 		return Issue{}, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
 	}
 
-	// var out bytes.Buffer
-	// json.Indent(&out, resp, "", "  ")
-	// fmt.Println(out.String())
-
 	// transform json into output
-	fieldsMap := data["fields"].(map[string]any)
-	statusMap := fieldsMap["status"].(map[string]any)
-	statusCategoryMap := statusMap["statusCategory"].(map[string]any)
-
-	// get the necessary fields for the struct
-	id := data["id"].(string)
-	key := data["key"].(string)
-	title := fieldsMap["summary"].(string)
-	descriptionMap, ok := fieldsMap["description"].(map[string]any)
-	description := ""
-	if ok {
-		description = getIssueDescriptionText(descriptionMap)
-	}
-	status := statusMap["name"].(string)
-	statusCategory := statusCategoryMap["name"].(string)
-	url := data["self"].(string)
-
-	// form return struct
-	outIssue := Issue{
-		ID:             id,
-		Key:            key,
-		Title:          title,
-		Description:    description,
-		Status:         status,
-		StatusCategory: statusCategory,
-		URL:            url,
-	}
+	outIssue := parseIssue(data)
 
 	return outIssue, nil
 }
@@ -136,54 +183,81 @@ func getIssueDescriptionText(descriptionMap map[string]any) string {
 	return strings.Join(descriptionSlice, "\n")
 }
 
+// CreateIssueOptions holds the fields used to create a Jira issue.
+// Project, IssueType, and Parent accept either a numeric ID or a key/name.
+// Assignee is an account ID, and defaults to the current user.
+type CreateIssueOptions struct {
+	Assignee     string
+	Project      string
+	IssueType    string
+	Title        string
+	Description  string
+	Parent       string
+	Labels       []string
+	Components   []string
+	CustomFields map[string]any
+}
+
 func (jira *Jira) CreateIssue(projectID string, issueTypeID string, title string, description string) (string, error) {
-	// get current user id
-	currentUserID, err := jira.getCurrentUserID()
-	if err != nil {
-		return "", fmt.Errorf("failed to get current user ID: %w", err)
-	}
-
-	// form description field if passed in
-	descriptionField := ""
-	if description != "" {
-		descriptionField = fmt.Sprintf(`"description": {
-      "content": [
-        {
-          "content": [
-            {
-              "text": "%s",
-              "type": "text"
-            }
-          ],
-          "type": "paragraph"
-        }
-      ],
-      "type": "doc",
-      "version": 1
-    },`, description)
+	return jira.CreateIssueWithOptions(CreateIssueOptions{
+		Project:     projectID,
+		IssueType:   issueTypeID,
+		Title:       title,
+		Description: description,
+	})
+}
+
+func (jira *Jira) CreateIssueWithOptions(options CreateIssueOptions) (string, error) {
+	// assign the issue to the current user by default
+	assignee := options.Assignee
+	if assignee == "" {
+		currentUserID, err := jira.getCurrentUserID()
+		if err != nil {
+			return "", fmt.Errorf("failed to get current user ID: %w", err)
+		}
+		assignee = currentUserID
 	}
 
 	// form request body
-	body := fmt.Sprintf(`{
-    "fields": {
-      "assignee": {
-        "id": "%s"
-      },
-      "project": {
-        "id": "%s"
-      },
-      "issuetype": {
-        "id": "%s"
-      },
-      %s
-      "summary": "%s"
-    },
-    "update": {}
-  }`, currentUserID, projectID, issueTypeID, descriptionField, title)
+	fields := map[string]any{
+		"assignee": map[string]string{
+			"id": assignee,
+		},
+		"project":   idOrKeyField(options.Project, "key"),
+		"issuetype": idOrKeyField(options.IssueType, "name"),
+		"summary":   options.Title,
+	}
+	if options.Description != "" {
+		fields["description"] = toDocument(options.Description)
+	}
+	if options.Parent != "" {
+		fields["parent"] = idOrKeyField(options.Parent, "key")
+	}
+	if len(options.Labels) > 0 {
+		fields["labels"] = options.Labels
+	}
+	if len(options.Components) > 0 {
+		components := make([]map[string]string, len(options.Components))
+		for i, component := range options.Components {
+			components[i] = map[string]string{"name": component}
+		}
+		fields["components"] = components
+	}
+	for field, value := range options.CustomFields {
+		fields[field] = value
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"fields": fields,
+		"update": map[string]any{},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to form request body: %w", err)
+	}
 
 	// call api
 	path := "rest/api/3/issue"
-	resp, err := jira.callAPI(path, "POST", bytes.NewBuffer([]byte(body)))
+	resp, err := jira.callAPI(path, "POST", bytes.NewBuffer(body))
 	if err != nil {
 		return "", fmt.Errorf("failed to call Jira API: %w", err)
 	}
@@ -200,3 +274,40 @@ func (jira *Jira) CreateIssue(projectID string, issueTypeID string, title string
 
 	return issueKey, nil
 }
+
+// idOrKeyField references a Jira entity by ID if the value is numeric, or by
+// keyName (e.g. "key" for projects, "name" for issue types) otherwise
+func idOrKeyField(value string, keyName string) map[string]string {
+	if _, err := strconv.Atoi(value); err == nil {
+		return map[string]string{"id": value}
+	}
+
+	return map[string]string{keyName: value}
+}
+
+// toDocument converts plain text into an Atlassian Document Format document,
+// with one paragraph per non-empty line
+func toDocument(text string) map[string]any {
+	paragraphs := []any{}
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		paragraphs = append(paragraphs, map[string]any{
+			"type": "paragraph",
+			"content": []any{
+				map[string]any{
+					"type": "text",
+					"text": line,
+				},
+			},
+		})
+	}
+
+	return map[string]any{
+		"type":    "doc",
+		"version": 1,
+		"content": paragraphs,
+	}
+}
@@ -71,22 +71,10 @@ func (jira *Jira) callAPI(path string, method string, body io.Reader) ([]byte, e
 }
 
 func (jira *Jira) getCurrentUserID() (string, error) {
-	// call api
-	path := "rest/api/3/myself"
-	resp, err := jira.callAPI(path, "GET", nil)
+	user, err := jira.GetCurrentUser()
 	if err != nil {
-		return "", fmt.Errorf("failed to call Jira API: %w", err)
+		return "", err
 	}
 
-	// parse json
-	var data map[string]any
-	err = json.Unmarshal(resp, &data)
-	if err != nil {
-		return "", fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
-	}
-
-	// transform json to output
-	accountID := data["accountID"].(string)
-
-	return accountID, nil
+	return user.AccountID, nil
 }
@@ -3,18 +3,57 @@ package jira
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
 // NOTE: follow Jira API reference
 type Project struct {
-	ID   string
-	Key  string
-	Name string
-	URL  string
+	ID          string      `json:"id"`
+	Key         string      `json:"key"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	ProjectType string      `json:"projectType"`
+	Lead        string      `json:"lead"`
+	URL         string      `json:"url"`
+	IssueTypes  []IssueType `json:"issueTypes"`
+	Components  []Component `json:"components"`
+	Versions    []Version   `json:"versions"`
+}
+
+// IssueType is an issue type available in a project. HierarchyLevel is 0 for
+// standard issue types, -1 for sub-tasks, and 1 or higher for epics and above.
+type IssueType struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	HierarchyLevel int    `json:"hierarchyLevel"`
+	Subtask        bool   `json:"subtask"`
+}
+
+type Component struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Lead        string `json:"lead"`
+}
+
+type Version struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+	ReleaseDate string `json:"releaseDate"`
 }
 
 func (jira *Jira) GetProjectByID(projectID int) (Project, error) {
-	path := fmt.Sprintf("rest/api/3/project/%d", projectID)
+	return jira.GetProject(strconv.Itoa(projectID))
+}
+
+// GetProject returns a project by its ID or key, with its issue types,
+// components, and versions
+func (jira *Jira) GetProject(projectIDOrKey string) (Project, error) {
+	path := fmt.Sprintf("rest/api/3/project/%s?expand=description,lead,issueTypes", url.PathEscape(projectIDOrKey))
 	resp, err := jira.callAPI(path, "GET", nil)
 	if err != nil {
 		return Project{}, fmt.Errorf("failed to call Jira API: %w", err)
@@ -27,19 +66,165 @@ func (jira *Jira) GetProjectByID(projectID int) (Project, error) {
 		return Project{}, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
 	}
 
-	// get the necessary fields for the struct
-	id := data["id"].(string)
-	key := data["key"].(string)
-	name := data["name"].(string)
-	url := data["self"].(string)
+	// transform json into output
+	outProject := parseProject(data)
 
-	// form return struct
-	outProject := Project{
-		ID:   id,
-		Key:  key,
-		Name: name,
-		URL:  url,
+	issueTypeSlice, _ := data["issueTypes"].([]any)
+	for _, issueType := range issueTypeSlice {
+		outProject.IssueTypes = append(outProject.IssueTypes, parseIssueType(issueType.(map[string]any)))
+	}
+
+	componentSlice, _ := data["components"].([]any)
+	for _, component := range componentSlice {
+		componentMap := component.(map[string]any)
+		outComponent := Component{
+			ID:   componentMap["id"].(string),
+			Name: componentMap["name"].(string),
+		}
+		outComponent.Description, _ = componentMap["description"].(string)
+		if leadMap, ok := componentMap["lead"].(map[string]any); ok {
+			outComponent.Lead, _ = leadMap["displayName"].(string)
+		}
+		outProject.Components = append(outProject.Components, outComponent)
+	}
+
+	versionSlice, _ := data["versions"].([]any)
+	for _, version := range versionSlice {
+		versionMap := version.(map[string]any)
+		outVersion := Version{
+			ID:   versionMap["id"].(string),
+			Name: versionMap["name"].(string),
+		}
+		outVersion.Released, _ = versionMap["released"].(bool)
+		outVersion.Archived, _ = versionMap["archived"].(bool)
+		outVersion.ReleaseDate, _ = versionMap["releaseDate"].(string)
+		outProject.Versions = append(outProject.Versions, outVersion)
 	}
 
 	return outProject, nil
 }
+
+// ListProjects returns all projects visible to the user, following pagination
+func (jira *Jira) ListProjects() ([]Project, error) {
+	return jira.SearchProjects("")
+}
+
+// SearchProjects returns the projects whose key or name contains the query,
+// following pagination
+func (jira *Jira) SearchProjects(searchQuery string) ([]Project, error) {
+	outProjects := []Project{}
+
+	for startAt := 0; ; {
+		// call api
+		query := url.Values{}
+		query.Set("startAt", strconv.Itoa(startAt))
+		query.Set("maxResults", "50")
+		query.Set("orderBy", "key")
+		query.Set("expand", "description,lead")
+		if searchQuery != "" {
+			query.Set("query", searchQuery)
+		}
+		path := fmt.Sprintf("rest/api/3/project/search?%s", query.Encode())
+		resp, err := jira.callAPI(path, "GET", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// parse json
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+		}
+
+		// transform json into output
+		values := data["values"].([]any)
+		for _, project := range values {
+			outProjects = append(outProjects, parseProject(project.(map[string]any)))
+		}
+
+		// stop at the last page
+		if isLast, _ := data["isLast"].(bool); isLast || len(values) == 0 {
+			break
+		}
+		startAt += len(values)
+	}
+
+	return outProjects, nil
+}
+
+// parseProject transforms a project object from the Jira API into a Project,
+// without its issue types, components, and versions
+func parseProject(projectMap map[string]any) Project {
+	outProject := Project{
+		ID:   projectMap["id"].(string),
+		Key:  projectMap["key"].(string),
+		Name: projectMap["name"].(string),
+		URL:  projectMap["self"].(string),
+	}
+
+	// optional fields that are only returned when requested
+	outProject.Description, _ = projectMap["description"].(string)
+	outProject.ProjectType, _ = projectMap["projectTypeKey"].(string)
+	if leadMap, ok := projectMap["lead"].(map[string]any); ok {
+		outProject.Lead, _ = leadMap["displayName"].(string)
+	}
+
+	return outProject
+}
+
+// GetProjectIssueTypes returns the issue types that issues can be created with in
+// a project, following pagination
+func (jira *Jira) GetProjectIssueTypes(projectIDOrKey string) ([]IssueType, error) {
+	outIssueTypes := []IssueType{}
+
+	for startAt := 0; ; {
+		// call api
+		path := fmt.Sprintf("rest/api/3/issue/createmeta/%s/issuetypes?startAt=%d&maxResults=50", url.PathEscape(projectIDOrKey), startAt)
+		resp, err := jira.callAPI(path, "GET", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// parse json
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+		}
+
+		// transform json into output
+		values, ok := data["issueTypes"].([]any)
+		if !ok {
+			values, _ = data["values"].([]any)
+		}
+		for _, issueType := range values {
+			outIssueTypes = append(outIssueTypes, parseIssueType(issueType.(map[string]any)))
+		}
+
+		// stop at the last page
+		total, _ := data["total"].(float64)
+		startAt += len(values)
+		if len(values) == 0 || startAt >= int(total) {
+			break
+		}
+	}
+
+	return outIssueTypes, nil
+}
+
+func parseIssueType(issueTypeMap map[string]any) IssueType {
+	outIssueType := IssueType{
+		ID:   issueTypeMap["id"].(string),
+		Name: issueTypeMap["name"].(string),
+	}
+	outIssueType.Description, _ = issueTypeMap["description"].(string)
+	outIssueType.Subtask, _ = issueTypeMap["subtask"].(bool)
+	if hierarchyLevel, ok := issueTypeMap["hierarchyLevel"].(float64); ok {
+		outIssueType.HierarchyLevel = int(hierarchyLevel)
+	} else if outIssueType.Subtask {
+		outIssueType.HierarchyLevel = -1
+	}
+
+	return outIssueType
+}
@@ -0,0 +1,95 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// RankIssues moves issues before or after another issue in the rank order, keeping
+// their relative order. Exactly one of beforeKey and afterKey must be set.
+func (jira *Jira) RankIssues(issueKeys []string, beforeKey string, afterKey string) error {
+	const batchSize = 50
+
+	if (beforeKey == "") == (afterKey == "") {
+		return fmt.Errorf("exactly one of beforeKey and afterKey must be set")
+	}
+
+	for start := 0; start < len(issueKeys); start += batchSize {
+		end := min(start+batchSize, len(issueKeys))
+
+		// rank each following batch right after the previous one to keep the order
+		request := map[string]any{
+			"issues": issueKeys[start:end],
+		}
+		switch {
+		case start > 0:
+			request["rankAfterIssue"] = issueKeys[start-1]
+		case beforeKey != "":
+			request["rankBeforeIssue"] = beforeKey
+		default:
+			request["rankAfterIssue"] = afterKey
+		}
+
+		body, err := json.Marshal(request)
+		if err != nil {
+			return fmt.Errorf("failed to form request body: %w", err)
+		}
+
+		// call api
+		path := "rest/agile/1.0/issue/rank"
+		resp, err := jira.callAPI(path, "PUT", bytes.NewBuffer(body))
+		if err != nil {
+			return fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// a partial failure returns the status of each issue
+		if len(resp) == 0 {
+			continue
+		}
+
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+		}
+
+		var failures []string
+		entries, _ := data["entries"].([]any)
+		for _, entry := range entries {
+			entryMap := entry.(map[string]any)
+			if status, _ := entryMap["status"].(float64); status >= 200 && status <= 299 {
+				continue
+			}
+
+			errors, _ := entryMap["errors"].([]any)
+			messages := make([]string, len(errors))
+			for i, message := range errors {
+				messages[i] = fmt.Sprint(message)
+			}
+			failures = append(failures, fmt.Sprintf("%v: %s", entryMap["issueKey"], strings.Join(messages, "; ")))
+		}
+		if len(failures) > 0 {
+			return fmt.Errorf("failed to rank issues:\n%s", strings.Join(failures, "\n"))
+		}
+	}
+
+	return nil
+}
+
+// GetBacklogIssues returns the issues in a board's backlog, in rank order,
+// following pagination. If storyPointsField is set, the issues' story points are
+// read from that field.
+func (jira *Jira) GetBacklogIssues(boardID int, storyPointsField string) ([]Issue, error) {
+	query := url.Values{}
+	fields := "summary,status,issuetype,assignee,priority"
+	if storyPointsField != "" {
+		fields += "," + storyPointsField
+	}
+	query.Set("fields", fields)
+
+	path := fmt.Sprintf("rest/agile/1.0/board/%d/backlog", boardID)
+	return jira.getAgileIssues(path, query, storyPointsField)
+}
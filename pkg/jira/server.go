@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// NOTE: follow Jira API reference
+type User struct {
+	AccountID   string `json:"accountId"`
+	DisplayName string `json:"displayName"`
+	Email       string `json:"email"`
+	Active      bool   `json:"active"`
+	TimeZone    string `json:"timeZone"`
+}
+
+type ServerInfo struct {
+	BaseURL        string `json:"baseUrl"`
+	Version        string `json:"version"`
+	DeploymentType string `json:"deploymentType"`
+	ServerTitle    string `json:"serverTitle"`
+}
+
+// GetCurrentUser returns the user the API token belongs to
+func (jira *Jira) GetCurrentUser() (User, error) {
+	// call api
+	path := "rest/api/3/myself"
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return User{}, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data map[string]any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return User{}, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	// transform json to output, a response without an account ID doesn't come
+	// from Jira
+	accountID, ok := data["accountId"].(string)
+	if !ok {
+		return User{}, fmt.Errorf("the response from the Jira API has no account ID")
+	}
+	outUser := User{
+		AccountID: accountID,
+	}
+	outUser.DisplayName, _ = data["displayName"].(string)
+	outUser.Email, _ = data["emailAddress"].(string)
+	outUser.Active, _ = data["active"].(bool)
+	outUser.TimeZone, _ = data["timeZone"].(string)
+
+	return outUser, nil
+}
+
+// GetServerInfo returns the version and deployment type (Cloud or Server) of
+// the Jira site
+func (jira *Jira) GetServerInfo() (ServerInfo, error) {
+	// call api
+	path := "rest/api/3/serverInfo"
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return ServerInfo{}, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data map[string]any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return ServerInfo{}, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	// transform json to output
+	outServerInfo := ServerInfo{}
+	outServerInfo.BaseURL, _ = data["baseUrl"].(string)
+	outServerInfo.Version, _ = data["version"].(string)
+	outServerInfo.DeploymentType, _ = data["deploymentType"].(string)
+	outServerInfo.ServerTitle, _ = data["serverTitle"].(string)
+
+	return outServerInfo, nil
+}
+
+// GetMyPermissions returns whether the current user has each of the permissions
+// (e.g. CREATE_ISSUES) in a project
+func (jira *Jira) GetMyPermissions(projectID string, permissions []string) (map[string]bool, error) {
+	// call api
+	query := url.Values{}
+	query.Set("permissions", strings.Join(permissions, ","))
+	if projectID != "" {
+		query.Set("projectId", projectID)
+	}
+	path := fmt.Sprintf("rest/api/3/mypermissions?%s", query.Encode())
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data map[string]any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	// transform json to output
+	outPermissions := map[string]bool{}
+	permissionsMap, _ := data["permissions"].(map[string]any)
+	for _, permission := range permissions {
+		permissionMap, _ := permissionsMap[permission].(map[string]any)
+		outPermissions[permission], _ = permissionMap["havePermission"].(bool)
+	}
+
+	return outPermissions, nil
+}
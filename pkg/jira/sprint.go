@@ -0,0 +1,157 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// NOTE: follow Jira API reference
+type Sprint struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	State        string    `json:"state"`
+	Goal         string    `json:"goal"`
+	BoardID      int       `json:"boardId"`
+	StartDate    time.Time `json:"startDate"`
+	EndDate      time.Time `json:"endDate"`
+	CompleteDate time.Time `json:"completeDate"`
+}
+
+// GetSprints returns the sprints of a board, optionally filtered by state
+// (comma-separated list of future, active, closed), following pagination
+func (jira *Jira) GetSprints(boardID int, state string) ([]Sprint, error) {
+	outSprints := []Sprint{}
+
+	for startAt := 0; ; {
+		// call api
+		query := url.Values{}
+		query.Set("startAt", strconv.Itoa(startAt))
+		query.Set("maxResults", "50")
+		if state != "" {
+			query.Set("state", state)
+		}
+		path := fmt.Sprintf("rest/agile/1.0/board/%d/sprint?%s", boardID, query.Encode())
+		resp, err := jira.callAPI(path, "GET", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call Jira API: %w", err)
+		}
+
+		// parse json
+		var data map[string]any
+		err = json.Unmarshal(resp, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+		}
+
+		// transform json into output
+		values := data["values"].([]any)
+		for _, sprint := range values {
+			outSprints = append(outSprints, parseSprint(sprint.(map[string]any)))
+		}
+
+		// stop at the last page
+		if isLast, _ := data["isLast"].(bool); isLast || len(values) == 0 {
+			break
+		}
+		startAt += len(values)
+	}
+
+	return outSprints, nil
+}
+
+func (jira *Jira) GetSprint(sprintID int) (Sprint, error) {
+	// call api
+	path := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return Sprint{}, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data map[string]any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return Sprint{}, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	return parseSprint(data), nil
+}
+
+func parseSprint(sprintMap map[string]any) Sprint {
+	outSprint := Sprint{
+		ID:    int(sprintMap["id"].(float64)),
+		Name:  sprintMap["name"].(string),
+		State: sprintMap["state"].(string),
+	}
+	outSprint.Goal, _ = sprintMap["goal"].(string)
+	if boardID, ok := sprintMap["originBoardId"].(float64); ok {
+		outSprint.BoardID = int(boardID)
+	}
+
+	// dates are only set once the sprint has started or completed
+	outSprint.StartDate = parseTime(sprintMap["startDate"])
+	outSprint.EndDate = parseTime(sprintMap["endDate"])
+	outSprint.CompleteDate = parseTime(sprintMap["completeDate"])
+
+	return outSprint
+}
+
+// parseTime parses a date-time from the Jira API, returning the zero time if
+// it's missing or invalid
+func parseTime(value any) time.Time {
+	str, ok := value.(string)
+	if !ok {
+		return time.Time{}
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05.000-0700"} {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// GetSprintIssues returns all issues in a sprint, following pagination. If
+// storyPointsField is set, the issues' story points are read from that field.
+func (jira *Jira) GetSprintIssues(sprintID int, storyPointsField string) ([]Issue, error) {
+	query := url.Values{}
+	fields := "summary,status,issuetype,assignee"
+	if storyPointsField != "" {
+		fields += "," + storyPointsField
+	}
+	query.Set("fields", fields)
+
+	path := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
+	return jira.getAgileIssues(path, query, storyPointsField)
+}
+
+// MoveIssuesToSprint moves issues to a sprint, in batches of at most 50 issues
+func (jira *Jira) MoveIssuesToSprint(sprintID int, issueKeys []string) error {
+	const batchSize = 50
+
+	for start := 0; start < len(issueKeys); start += batchSize {
+		end := min(start+batchSize, len(issueKeys))
+
+		body, err := json.Marshal(map[string]any{
+			"issues": issueKeys[start:end],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to form request body: %w", err)
+		}
+
+		// call api
+		path := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
+		_, err = jira.callAPI(path, "POST", bytes.NewBuffer(body))
+		if err != nil {
+			return fmt.Errorf("failed to call Jira API: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NOTE: follow Jira API reference
+type Status struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Category    string `json:"category"`
+	CategoryKey string `json:"categoryKey"`
+}
+
+// GetStatuses returns all statuses visible to the user, with their status category
+func (jira *Jira) GetStatuses() ([]Status, error) {
+	// call api
+	path := "rest/api/3/status"
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data []any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	// transform json into output
+	outStatuses := make([]Status, len(data))
+	for i, status := range data {
+		statusMap := status.(map[string]any)
+		statusCategoryMap := statusMap["statusCategory"].(map[string]any)
+		outStatuses[i] = Status{
+			ID:          statusMap["id"].(string),
+			Name:        statusMap["name"].(string),
+			Category:    statusCategoryMap["name"].(string),
+			CategoryKey: statusCategoryMap["key"].(string),
+		}
+	}
+
+	return outStatuses, nil
+}
@@ -4,18 +4,132 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // NOTE: follow Jira API reference
 type Transition struct {
-	ID       string
-	Name     string
-	Category string
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Category   string            `json:"category"`
+	ToStatus   string            `json:"toStatus"`
+	ToStatusID string            `json:"toStatusId"`
+	HasScreen  bool              `json:"hasScreen"`
+	Fields     []TransitionField `json:"fields"`
+}
+
+// TransitionField is a field on a transition's screen
+type TransitionField struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Required      bool     `json:"required"`
+	HasDefault    bool     `json:"hasDefault"`
+	Type          string   `json:"type"`
+	ItemsType     string   `json:"itemsType"`
+	AllowedValues []string `json:"allowedValues"`
+
+	// allowed values are referenced by "name" (e.g. resolutions, versions) or by
+	// "value" (e.g. select list options)
+	allowedValueKey string
+}
+
+// TransitionIssueOptions holds the screen fields and comment to send with a transition
+type TransitionIssueOptions struct {
+	Fields  map[string]any
+	Comment string
+}
+
+// RequiredFields returns the fields that must be filled in for the transition
+// to succeed, i.e. required fields without a default value
+func (transition Transition) RequiredFields() []TransitionField {
+	var fields []TransitionField
+	for _, field := range transition.Fields {
+		if field.Required && !field.HasDefault {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// FieldByName returns the transition field whose ID or name matches, case-insensitively
+func (transition Transition) FieldByName(name string) (TransitionField, bool) {
+	for _, field := range transition.Fields {
+		if strings.EqualFold(field.ID, name) || strings.EqualFold(field.Name, name) {
+			return field, true
+		}
+	}
+
+	return TransitionField{}, false
+}
+
+// FormatValue converts a value entered by the user into the shape the Jira API
+// expects for the field
+func (field TransitionField) FormatValue(value string) (any, error) {
+	key := field.allowedValueKey
+	if key == "" {
+		key = "name"
+	}
+
+	// array values are comma-separated, and each item is checked on its own
+	if field.Type == "array" {
+		var items []any
+		for _, item := range strings.Split(value, ",") {
+			item, err := field.allowedValue(strings.TrimSpace(item))
+			if err != nil {
+				return nil, err
+			}
+			if field.ItemsType == "string" {
+				items = append(items, item)
+			} else {
+				items = append(items, map[string]string{key: item})
+			}
+		}
+		return items, nil
+	}
+
+	value, err := field.allowedValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch field.Type {
+	case "number":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' must be a number", field.Name)
+		}
+		return number, nil
+	case "string", "date", "datetime":
+		return value, nil
+	case "user":
+		return map[string]string{"accountId": value}, nil
+	default:
+		return map[string]string{key: value}, nil
+	}
+}
+
+// allowedValue returns the allowed value matching the value case-insensitively,
+// or the value itself if the field doesn't restrict its values
+func (field TransitionField) allowedValue(value string) (string, error) {
+	if len(field.AllowedValues) == 0 {
+		return value, nil
+	}
+
+	for _, allowedValue := range field.AllowedValues {
+		if strings.EqualFold(allowedValue, value) {
+			return allowedValue, nil
+		}
+	}
+
+	return "", fmt.Errorf("'%s' is not a valid value for '%s', must be one of: %s", value, field.Name, strings.Join(field.AllowedValues, ", "))
 }
 
 func (jira *Jira) GetTransitions(issueID string) ([]Transition, error) {
 	// call api
-	path := fmt.Sprintf("rest/api/3/issue/%s/transitions", issueID)
+	path := fmt.Sprintf("rest/api/3/issue/%s/transitions?expand=transitions.fields", issueID)
 	resp, err := jira.callAPI(path, "GET", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call Jira API: %w", err)
@@ -40,30 +154,100 @@ func (jira *Jira) GetTransitions(issueID string) ([]Transition, error) {
 		id := transitionMap["id"].(string)
 		name := transitionMap["name"].(string)
 		categoryName := statusCategory["name"].(string)
+		toStatus := toMap["name"].(string)
+		toStatusID := toMap["id"].(string)
+		hasScreen, _ := transitionMap["hasScreen"].(bool)
 		outTransitions[i] = Transition{
-			ID:       id,
-			Name:     name,
-			Category: categoryName,
+			ID:         id,
+			Name:       name,
+			Category:   categoryName,
+			ToStatus:   toStatus,
+			ToStatusID: toStatusID,
+			HasScreen:  hasScreen,
+			Fields:     parseTransitionFields(transitionMap),
 		}
 	}
 
 	return outTransitions, nil
 }
 
+func parseTransitionFields(transitionMap map[string]any) []TransitionField {
+	fieldsMap, ok := transitionMap["fields"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	outFields := []TransitionField{}
+	for id, field := range fieldsMap {
+		fieldMap := field.(map[string]any)
+		outField := TransitionField{ID: id}
+		outField.Name, _ = fieldMap["name"].(string)
+		outField.Required, _ = fieldMap["required"].(bool)
+		outField.HasDefault, _ = fieldMap["hasDefaultValue"].(bool)
+		if schemaMap, ok := fieldMap["schema"].(map[string]any); ok {
+			outField.Type, _ = schemaMap["type"].(string)
+			outField.ItemsType, _ = schemaMap["items"].(string)
+		}
+
+		allowedValues, _ := fieldMap["allowedValues"].([]any)
+		for _, allowedValue := range allowedValues {
+			allowedValueMap, ok := allowedValue.(map[string]any)
+			if !ok {
+				continue
+			}
+			if name, ok := allowedValueMap["name"].(string); ok {
+				outField.AllowedValues = append(outField.AllowedValues, name)
+				outField.allowedValueKey = "name"
+			} else if value, ok := allowedValueMap["value"].(string); ok {
+				outField.AllowedValues = append(outField.AllowedValues, value)
+				outField.allowedValueKey = "value"
+			}
+		}
+
+		outFields = append(outFields, outField)
+	}
+	sort.Slice(outFields, func(i, j int) bool { return outFields[i].Name < outFields[j].Name })
+
+	return outFields
+}
+
 func (jira *Jira) TransitionIssue(issueID string, transitionID string) error {
+	return jira.TransitionIssueWithOptions(issueID, transitionID, TransitionIssueOptions{})
+}
+
+func (jira *Jira) TransitionIssueWithOptions(issueID string, transitionID string, options TransitionIssueOptions) error {
+	// form request body
+	request := map[string]any{
+		"transition": map[string]string{
+			"id": transitionID,
+		},
+	}
+	if len(options.Fields) > 0 {
+		request["fields"] = options.Fields
+	}
+	if options.Comment != "" {
+		request["update"] = map[string]any{
+			"comment": []any{
+				map[string]any{
+					"add": map[string]any{
+						"body": toDocument(options.Comment),
+					},
+				},
+			},
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to form request body: %w", err)
+	}
+
 	// call api
-	body := fmt.Sprintf(`{
-    "transition": {
-      "id": "%s"
-    }
-  }`, transitionID)
 	path := fmt.Sprintf("rest/api/3/issue/%s/transitions", issueID)
-	resp, err := jira.callAPI(path, "POST", bytes.NewBuffer([]byte(body)))
+	_, err = jira.callAPI(path, "POST", bytes.NewBuffer(body))
 	if err != nil {
 		return fmt.Errorf("failed to call Jira API: %w", err)
 	}
 
-	fmt.Println(string(resp))
-
 	return nil
 }
@@ -0,0 +1,222 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// NOTE: follow Jira API reference
+type Workflow struct {
+	Name        string               `json:"name"`
+	Statuses    []WorkflowStatus     `json:"statuses"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+type WorkflowStatus struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// WorkflowTransition is a transition between statuses in a workflow. Global
+// transitions have no From statuses and can be taken from any status.
+type WorkflowTransition struct {
+	ID   string   `json:"id"`
+	Name string   `json:"name"`
+	From []string `json:"from"`
+	To   string   `json:"to"`
+	Type string   `json:"type"`
+}
+
+// GetWorkflow returns the workflow used by an issue type in a project, based on
+// the project's workflow scheme
+func (jira *Jira) GetWorkflow(projectID string, issueTypeID string) (Workflow, error) {
+	workflowName, err := jira.getWorkflowName(projectID, issueTypeID)
+	if err != nil {
+		return Workflow{}, err
+	}
+
+	// call api
+	query := url.Values{}
+	query.Set("workflowName", workflowName)
+	query.Set("expand", "statuses,transitions")
+	path := fmt.Sprintf("rest/api/3/workflow/search?%s", query.Encode())
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return Workflow{}, fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data map[string]any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return Workflow{}, fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	values := data["values"].([]any)
+	if len(values) == 0 {
+		return Workflow{}, fmt.Errorf("workflow '%s' not found", workflowName)
+	}
+	workflowMap := values[0].(map[string]any)
+
+	// transform json into output
+	outWorkflow := Workflow{Name: workflowName}
+	statuses, _ := workflowMap["statuses"].([]any)
+	for _, status := range statuses {
+		statusMap := status.(map[string]any)
+		outWorkflow.Statuses = append(outWorkflow.Statuses, WorkflowStatus{
+			ID:   statusMap["id"].(string),
+			Name: statusMap["name"].(string),
+		})
+	}
+
+	transitions, _ := workflowMap["transitions"].([]any)
+	for _, transition := range transitions {
+		transitionMap := transition.(map[string]any)
+
+		var from []string
+		fromSlice, _ := transitionMap["from"].([]any)
+		for _, fromStatus := range fromSlice {
+			from = append(from, fromStatus.(string))
+		}
+
+		transitionType, _ := transitionMap["type"].(string)
+		outWorkflow.Transitions = append(outWorkflow.Transitions, WorkflowTransition{
+			ID:   fmt.Sprint(transitionMap["id"]),
+			Name: transitionMap["name"].(string),
+			From: from,
+			To:   transitionMap["to"].(string),
+			Type: transitionType,
+		})
+	}
+
+	return outWorkflow, nil
+}
+
+func (jira *Jira) getWorkflowName(projectID string, issueTypeID string) (string, error) {
+	// call api
+	path := fmt.Sprintf("rest/api/3/workflowscheme/project?projectId=%s", url.QueryEscape(projectID))
+	resp, err := jira.callAPI(path, "GET", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to call Jira API: %w", err)
+	}
+
+	// parse json
+	var data map[string]any
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal JSON response from Jira API: %w", err)
+	}
+
+	values := data["values"].([]any)
+	if len(values) == 0 {
+		return "", fmt.Errorf("no workflow scheme is associated with project %s", projectID)
+	}
+	schemeMap := values[0].(map[string]any)["workflowScheme"].(map[string]any)
+
+	// use the issue type's workflow if it's mapped, otherwise the scheme's default
+	if mappings, ok := schemeMap["issueTypeMappings"].(map[string]any); ok {
+		if workflowName, ok := mappings[issueTypeID].(string); ok {
+			return workflowName, nil
+		}
+	}
+
+	return schemeMap["defaultWorkflow"].(string), nil
+}
+
+// StatusByID returns the workflow status with the given ID
+func (workflow Workflow) StatusByID(statusID string) (WorkflowStatus, bool) {
+	for _, status := range workflow.Statuses {
+		if status.ID == statusID {
+			return status, true
+		}
+	}
+
+	return WorkflowStatus{}, false
+}
+
+// ShortestPath finds the shortest sequence of transitions that leads from one
+// status to another, or returns false if the target status can't be reached
+func (workflow Workflow) ShortestPath(fromStatusID string, toStatusID string) ([]WorkflowTransition, bool) {
+	if fromStatusID == toStatusID {
+		return []WorkflowTransition{}, true
+	}
+
+	// breadth-first search, keeping the transition and previous status used to
+	// reach each status
+	cameFrom := map[string]WorkflowTransition{}
+	previous := map[string]string{}
+	visited := map[string]bool{fromStatusID: true}
+	queue := []string{fromStatusID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, transition := range workflow.TransitionsFrom(current) {
+			if visited[transition.To] {
+				continue
+			}
+			visited[transition.To] = true
+			cameFrom[transition.To] = transition
+			previous[transition.To] = current
+			queue = append(queue, transition.To)
+
+			if transition.To != toStatusID {
+				continue
+			}
+
+			// walk back from the target to build the path
+			path := []WorkflowTransition{}
+			for status := toStatusID; status != fromStatusID; status = previous[status] {
+				path = append([]WorkflowTransition{cameFrom[status]}, path...)
+			}
+			return path, true
+		}
+	}
+
+	return nil, false
+}
+
+// TransitionsFrom returns the transitions that can be taken from a status,
+// including global transitions
+func (workflow Workflow) TransitionsFrom(statusID string) []WorkflowTransition {
+	var transitions []WorkflowTransition
+	for _, transition := range workflow.Transitions {
+		if transition.Type == "initial" {
+			continue
+		}
+
+		if len(transition.From) == 0 {
+			transitions = append(transitions, transition)
+			continue
+		}
+		for _, from := range transition.From {
+			if from == statusID {
+				transitions = append(transitions, transition)
+				break
+			}
+		}
+	}
+
+	return transitions
+}
+
+// ReachableFrom returns the IDs of the statuses that can be reached from a
+// status through one or more transitions
+func (workflow Workflow) ReachableFrom(statusID string) map[string]bool {
+	reachable := map[string]bool{}
+	queue := []string{statusID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, transition := range workflow.TransitionsFrom(current) {
+			if !reachable[transition.To] {
+				reachable[transition.To] = true
+				queue = append(queue, transition.To)
+			}
+		}
+	}
+
+	return reachable
+}